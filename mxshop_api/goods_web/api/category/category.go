@@ -2,10 +2,8 @@ package category
 
 import (
 	"context"
-	"encoding/json"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/protobuf/ptypes/empty"
-	"go.uber.org/zap"
 	"mxshop_api/goods_web/api"
 	"mxshop_api/goods_web/forms"
 	"mxshop_api/goods_web/global"
@@ -20,14 +18,52 @@ func List(c *gin.Context) {
 		api.HandleGrpcErrToHttp(err, c)
 		return
 	}
-	data := make([]interface{}, 0)
+	c.JSON(http.StatusOK, treeToList(Rsp.Tree))
+}
+
+// treeToList 分类树转换成前端使用的格式，字段和之前的jsonData一致
+func treeToList(tree []*proto.CategoryTree) []interface{} {
+	list := make([]interface{}, 0, len(tree))
+	for _, category := range tree {
+		list = append(list, map[string]interface{}{
+			"id":           category.Id,
+			"name":         category.Name,
+			"parent":       category.ParentCategory,
+			"level":        category.Level,
+			"is_tab":       category.IsTab,
+			"sub_category": treeToList(category.SubCategorys),
+		})
+	}
+	return list
+}
 
-	//json反序列化
-	err = json.Unmarshal([]byte(Rsp.JsonData), &data)
+// Breadcrumbs 分类的路径，从一级分类开始
+func Breadcrumbs(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
 	if err != nil {
-		zap.S().Info("List [查询] 【分类列表】失败", err.Error())
+		c.Status(http.StatusNotFound)
+		return
 	}
-	c.JSON(http.StatusOK, data)
+	Rsp, err := global.GoodsSrvClient.CategoryBreadcrumbs(context.WithValue(context.Background(), "ginContext", c), &proto.BreadcrumbsRequest{
+		CategoryId: int32(id),
+	})
+	if err != nil {
+		api.HandleGrpcErrToHttp(err, c)
+		return
+	}
+	c.JSON(http.StatusOK, BreadcrumbsToList(Rsp.Data))
+}
+
+// BreadcrumbsToList 分类路径转换成前端使用的格式，商品详情页也使用
+func BreadcrumbsToList(path []*proto.CategoryBriefInfoResponse) []interface{} {
+	list := make([]interface{}, 0, len(path))
+	for _, category := range path {
+		list = append(list, map[string]interface{}{
+			"id":   category.Id,
+			"name": category.Name,
+		})
+	}
+	return list
 }
 
 func Detail(c *gin.Context) {
@@ -145,3 +181,26 @@ func Update(c *gin.Context) {
 
 	c.Status(http.StatusOK)
 }
+
+// Move 把分类连同子分类移动到新的父分类下
+func Move(c *gin.Context) {
+	moveForm := forms.MoveCategoryForm{}
+	if err := c.ShouldBindJSON(&moveForm); err != nil {
+		api.HandleValidatorErr(c, err)
+		return
+	}
+	id, err := strconv.ParseInt(c.Param("id"), 10, 32)
+	if err != nil {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	_, err = global.GoodsSrvClient.MoveCategory(context.WithValue(context.Background(), "ginContext", c), &proto.MoveCategoryRequest{
+		Id:             int32(id),
+		ParentCategory: *moveForm.Parent,
+	})
+	if err != nil {
+		api.HandleGrpcErrToHttp(err, c)
+		return
+	}
+	c.Status(http.StatusOK)
+}
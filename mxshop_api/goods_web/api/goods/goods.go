@@ -8,10 +8,12 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"mxshop_api/goods_web/api/category"
 	"mxshop_api/goods_web/forms"
 	"mxshop_api/goods_web/global"
 	"mxshop_api/goods_web/proto"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -50,9 +52,11 @@ func HandleGrpcErrorToHttp(err error, c *gin.Context) {
 func HandleValidatorError(c *gin.Context, err error) {
 	errs, ok := err.(validator.ValidationErrors)
 	if !ok {
-		c.JSON(http.StatusOK, gin.H{
+		// 参数类型不正确等不是校验规则产生的错误
+		c.JSON(http.StatusBadRequest, gin.H{
 			"msg": err.Error(),
 		})
+		return
 	}
 	c.JSON(http.StatusBadRequest, gin.H{
 		"error": removeTopStruct(errs.Translate(global.Trans)),
@@ -67,53 +71,89 @@ func removeTopStruct(fileds map[string]string) map[string]string {
 	}
 	return rsp
 }
-func List(ctx *gin.Context) {
-	fmt.Println("商品列表")
-	// 商品的列表
-	// 根据前端的请求参数构造request对象
-	request := &proto.GoodsFilterRequest{}
-	priceMin := ctx.DefaultQuery("pmin", "0")
-	priceMinInt, _ := strconv.Atoi(priceMin)
-	request.PriceMin = int32(priceMinInt)
 
-	priceMax := ctx.DefaultQuery("pmax", "0")
-	priceMaxInt, _ := strconv.Atoi(priceMax)
-	request.PriceMax = int32(priceMaxInt)
+// goodsSorts 商品列表的排序参数
+var goodsSorts = map[string]proto.GoodsSort{
+	"price_asc":  proto.GoodsSort_SORT_PRICE_ASC,
+	"price_desc": proto.GoodsSort_SORT_PRICE_DESC,
+	"sold":       proto.GoodsSort_SORT_SOLD,
+	"click":      proto.GoodsSort_SORT_CLICK,
+	"newest":     proto.GoodsSort_SORT_NEWEST,
+	"relevance":  proto.GoodsSort_SORT_RELEVANCE,
+}
 
-	isHot := ctx.DefaultQuery("ih", "0")
-	if isHot == "1" {
-		request.IsHot = true
-	}
+// legacyListQuery 之前版本的查询参数，旧的参数转换成新的参数之后再校验，同时传了新参数时以新参数为准
+// ih、in、it、f是0或者1，c和b为0表示不筛选，pb是逗号分隔的价格区间分界点
+var legacyListQuery = map[string]string{
+	"pmin": "price_min",
+	"pmax": "price_max",
+	"ih":   "is_hot",
+	"in":   "is_new",
+	"it":   "is_tab",
+	"c":    "category",
+	"b":    "brand",
+	"p":    "page",
+	"pnum": "page_size",
+	"f":    "facets",
+	"pb":   "price_bucket",
+}
 
-	isNew := ctx.DefaultQuery("in", "0")
-	if isNew == "1" {
-		request.IsNew = true
+func convertLegacyQuery(query url.Values) {
+	for old, key := range legacyListQuery {
+		value, ok := query[old]
+		if !ok {
+			continue
+		}
+		query.Del(old)
+		if _, ok := query[key]; ok || len(value) == 0 {
+			continue
+		}
+		switch old {
+		case "ih", "in", "it", "f":
+			query.Set(key, strconv.FormatBool(value[0] == "1"))
+		case "c", "b":
+			if value[0] != "0" {
+				query.Set(key, value[0])
+			}
+		case "pb":
+			for _, bound := range strings.Split(value[0], ",") {
+				query.Add(key, strings.TrimSpace(bound))
+			}
+		default:
+			query.Set(key, value[0])
+		}
 	}
+}
 
-	isTab := ctx.DefaultQuery("it", "0")
-	if isTab == "1" {
-		request.IsTab = true
+func List(ctx *gin.Context) {
+	fmt.Println("商品列表")
+	// 商品的列表
+	// 根据前端的请求参数构造request对象
+	// 品牌和分类可以传多个：?brand=1&brand=2
+	query := ctx.Request.URL.Query()
+	convertLegacyQuery(query)
+	ctx.Request.URL.RawQuery = query.Encode()
+	filterForm := forms.GoodsFilterForm{}
+	if err := ctx.ShouldBindQuery(&filterForm); err != nil {
+		HandleValidatorError(ctx, err)
+		return
+	}
+	request := &proto.GoodsFilterRequest{
+		KeyWords:     filterForm.Keywords,
+		PriceMin:     filterForm.PriceMin,
+		PriceMax:     filterForm.PriceMax,
+		IsHot:        filterForm.IsHot,
+		IsNew:        filterForm.IsNew,
+		IsTab:        filterForm.IsTab,
+		OnSale:       filterForm.OnSale == nil || *filterForm.OnSale,
+		Categories:   filterForm.Categories,
+		Brands:       filterForm.Brands,
+		Sort:         goodsSorts[filterForm.Sort],
+		Pages:        filterForm.Page,
+		PagePerNums:  filterForm.PageSize,
+		WithFacets:   filterForm.Facets,
+		PriceBuckets: filterForm.PriceBuckets,
 	}
-
-	categoryId := ctx.DefaultQuery("c", "0")
-	categoryIdInt, _ := strconv.Atoi(categoryId)
-	request.TopCategory = int32(categoryIdInt)
-
-	pages := ctx.DefaultQuery("p", "0")
-	pagesInt, _ := strconv.Atoi(pages)
-	request.Pages = int32(pagesInt)
-
-	perNums := ctx.DefaultQuery("pnum", "0")
-	perNumsInt, _ := strconv.Atoi(perNums)
-	request.PagePerNums = int32(perNumsInt)
-
-	keywords := ctx.DefaultQuery("q", "")
-	request.KeyWords = keywords
-
-	brandId := ctx.DefaultQuery("b", "0")
-	brandIdInt, _ := strconv.Atoi(brandId)
-
-	request.Brand = int32(brandIdInt)
 
 	// 请求商品的service服务
 	r, err := global.GoodsSrvClient.GoodsList(context.WithValue(context.Background(), "ginContext", ctx), request)
@@ -148,16 +188,56 @@ func List(ctx *gin.Context) {
 				"name": value.Brand.Name,
 				"logo": value.Brand.Logo,
 			},
-			"is_hot":  value.IsHot,
-			"is_new":  value.IsNew,
-			"on_sale": value.OnSale,
+			"is_hot":     value.IsHot,
+			"is_new":     value.IsNew,
+			"on_sale":    value.OnSale,
+			"rating":     value.Rating,
+			"review_num": value.ReviewNum,
 		})
 	}
 	reMap["data"] = goodsList
+	if r.Facets != nil {
+		reMap["facets"] = facetsToMap(r.Facets)
+	}
 
 	ctx.JSON(http.StatusOK, reMap)
 }
 
+func facetsToMap(facets *proto.GoodsFacets) map[string]interface{} {
+	brands := make([]interface{}, 0, len(facets.Brands))
+	for _, brand := range facets.Brands {
+		brands = append(brands, map[string]interface{}{
+			"id":    brand.Id,
+			"name":  brand.Name,
+			"count": brand.Count,
+		})
+	}
+	categories := make([]interface{}, 0, len(facets.Categories))
+	for _, category := range facets.Categories {
+		categories = append(categories, map[string]interface{}{
+			"id":    category.Id,
+			"name":  category.Name,
+			"count": category.Count,
+		})
+	}
+	prices := make([]interface{}, 0, len(facets.Prices))
+	for _, price := range facets.Prices {
+		prices = append(prices, map[string]interface{}{
+			"min":   price.Min,
+			"max":   price.Max,
+			"count": price.Count,
+		})
+	}
+	return map[string]interface{}{
+		"brands":     brands,
+		"categories": categories,
+		"prices":     prices,
+		"is_hot":     facets.IsHot,
+		"is_new":     facets.IsNew,
+		"ship_free":  facets.ShipFree,
+	}
+}
+
 // New 添加商品
 func New(ctx *gin.Context) {
 	goodsForm := forms.GoodsForm{}
@@ -173,6 +253,7 @@ func New(ctx *gin.Context) {
 		MarketPrice:     goodsForm.MarketPrice,
 		ShopPrice:       goodsForm.ShopPrice,
 		GoodsBrief:      goodsForm.GoodsBrief,
+		GoodsDesc:       goodsForm.GoodsDesc,
 		ShipFree:        *goodsForm.ShipFree,
 		Images:          goodsForm.Images,
 		DescImages:      goodsForm.DescImages,
@@ -185,8 +266,19 @@ func New(ctx *gin.Context) {
 		HandleGrpcErrorToHttp(err, ctx)
 		return
 	}
-	// 如何设置库存
-	// TODO 商品的库存 - 分布式事务
+
+	// 商品和库存在两个服务中，设置库存失败时删除刚创建的商品，不会留下没有库存的商品
+	if _, err = global.InventorySrvClient.SetInv(context.Background(), &proto.GoodsInvInfo{
+		GoodsId: rsp.Id,
+		Num:     goodsForm.Stocks,
+	}); err != nil {
+		zap.S().Errorf("[New] 商品 %d 设置库存失败: %s", rsp.Id, err.Error())
+		if _, delErr := goodsClient.DeleteGoods(context.Background(), &proto.DeleteGoodsInfo{Id: rsp.Id}); delErr != nil {
+			zap.S().Errorf("[New] 设置库存失败之后删除商品 %d 失败: %s", rsp.Id, delErr.Error())
+		}
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
 	ctx.JSON(http.StatusOK, rsp)
 }
 
@@ -203,6 +295,7 @@ func Detail(ctx *gin.Context) {
 	})
 	if err != nil {
 		HandleGrpcErrorToHttp(err, ctx)
+		return
 	}
 	rsp := map[string]interface{}{
 		"id":          r.Id,
@@ -223,13 +316,49 @@ func Detail(ctx *gin.Context) {
 			"name": r.Brand.Name,
 			"logo": r.Brand.Logo,
 		},
-		"is_hot":  r.IsHot,
-		"is_new":  r.IsNew,
-		"on_sale": r.OnSale,
+		"is_hot":     r.IsHot,
+		"is_new":     r.IsNew,
+		"on_sale":    r.OnSale,
+		"rating":     r.Rating,
+		"review_num": r.ReviewNum,
+		"specs":      specsToList(r.Specs),
+		"skus":       skusToList(r.Skus),
 	}
 	ctx.JSON(http.StatusOK, rsp)
 }
 
+// specsToList 商品可以选择的规格，前端根据规格组合找到对应的sku
+func specsToList(specs []*proto.SpecDimension) []interface{} {
+	list := make([]interface{}, 0, len(specs))
+	for _, spec := range specs {
+		list = append(list, map[string]interface{}{
+			"name":   spec.Name,
+			"values": spec.Values,
+		})
+	}
+	return list
+}
+
+func skusToList(skus []*proto.SkuInfo) []interface{} {
+	list := make([]interface{}, 0, len(skus))
+	for _, sku := range skus {
+		specs := make(map[string]string, len(sku.Specs))
+		for _, spec := range sku.Specs {
+			specs[spec.Name] = spec.Value
+		}
+		list = append(list, map[string]interface{}{
+			"id":           sku.Id,
+			"sku_sn":       sku.SkuSn,
+			"specs":        specs,
+			"market_price": sku.MarketPrice,
+			"shop_price":   sku.ShopPrice,
+			"image":        sku.Image,
+			"on_sale":      sku.OnSale,
+		})
+	}
+	return list
+}
+
 // Delete 删除商品
 func Delete(ctx *gin.Context) {
 	id := ctx.Param("id")
@@ -247,17 +376,50 @@ func Delete(ctx *gin.Context) {
 	return
 }
 
+// Breadcrumbs 商品所在分类的路径，从一级分类开始
+func Breadcrumbs(ctx *gin.Context) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+	rsp, err := global.GoodsSrvClient.CategoryBreadcrumbs(context.Background(), &proto.BreadcrumbsRequest{
+		GoodsId: int32(id),
+	})
+	if err != nil {
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, category.BreadcrumbsToList(rsp.Data))
+}
+
 // Stocks 获取库存
 func Stocks(ctx *gin.Context) {
 	id := ctx.Param("id")
-	_, err := strconv.ParseInt(id, 10, 32)
+	i, err := strconv.ParseInt(id, 10, 32)
 	if err != nil {
 		ctx.Status(http.StatusNotFound)
 		return
 	}
 
-	//TODO 商品的库存
-	return
+	r, err := global.InventorySrvClient.InvDetail(context.Background(), &proto.GoodsInvInfo{GoodsId: int32(i)})
+	if err != nil {
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	warehouses := make([]interface{}, 0, len(r.Warehouses))
+	for _, warehouse := range r.Warehouses {
+		warehouses = append(warehouses, map[string]interface{}{
+			"id":     warehouse.WarehouseId,
+			"name":   warehouse.Name,
+			"stocks": warehouse.Num,
+		})
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"goods_id":   r.GoodsId,
+		"stocks":     r.Num,
+		"warehouses": warehouses,
+	})
 }
 
 // UpdateStatus 更新商品状态
@@ -302,6 +464,7 @@ func Update(ctx *gin.Context) {
 		MarketPrice:     goodsForm.MarketPrice,
 		ShopPrice:       goodsForm.ShopPrice,
 		GoodsBrief:      goodsForm.GoodsBrief,
+		GoodsDesc:       goodsForm.GoodsDesc,
 		ShipFree:        *goodsForm.ShipFree,
 		Images:          goodsForm.Images,
 		DescImages:      goodsForm.DescImages,
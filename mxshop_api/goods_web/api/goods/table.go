@@ -0,0 +1,477 @@
+package goods
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes/empty"
+	"go.uber.org/zap"
+	gproto "google.golang.org/protobuf/proto"
+
+	"mxshop_api/goods_web/forms"
+	"mxshop_api/goods_web/global"
+	"mxshop_api/goods_web/proto"
+	"mxshop_api/goods_web/utils"
+)
+
+/*
+	商品的批量导入和导出，导入和导出使用相同的列，导出的文件修改之后可以直接导入
+	分类使用 一级分类/二级分类/三级分类 的完整路径，导入时名称不重复的分类也可以只写名称
+	多张图片之间使用 | 分隔，布尔值可以写 是/否、true/false、1/0
+	商品编号已经存在时修改商品，否则新建商品；stocks为空时不修改库存
+*/
+
+const (
+	maxImportSize  = 20 << 20 // 导入文件最大20MB
+	maxImportRows  = 10000
+	importBatch    = 200     // 每次调用ImportGoods的行数
+	importBatchLen = 2 << 20 // 每次调用ImportGoods的消息大小，grpc默认最大4MB
+	exportPageSize = 100
+)
+
+// goodsColumns 导入导出文件的列，导入时除了stocks都必须有
+var goodsColumns = []string{
+	"goods_sn", "name", "category", "brand", "market_price", "shop_price", "stocks", "goods_brief",
+	"ship_free", "is_new", "is_hot", "on_sale", "front_image", "images", "desc_images", "goods_desc",
+}
+
+// importRow 文件中的一行，stocks为nil表示不修改库存
+type importRow struct {
+	row    *proto.ImportGoodsRow
+	stocks *int32
+}
+
+// importError 某一行的错误，line是文件中的行号，从1开始，表头是第1行
+type importError struct {
+	Line int32  `json:"line"`
+	Msg  string `json:"msg"`
+}
+
+// Import 从csv或者xlsx文件导入商品，multipart表单的file字段
+// dry_run=true时只检查文件的内容，不保存
+// 所有的行都检查通过之后才开始保存，有错误时返回每一行的错误
+func Import(ctx *gin.Context) {
+	dryRun, _ := strconv.ParseBool(ctx.DefaultQuery("dry_run", "false"))
+	file, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "请选择导入的文件",
+		})
+		return
+	}
+	format := utils.TableFormat(file.Filename)
+	if format == "" {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "只支持csv和xlsx文件",
+		})
+		return
+	}
+	if file.Size > maxImportSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": fmt.Sprintf("文件不能超过%dMB", maxImportSize>>20),
+		})
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "读取文件失败",
+		})
+		return
+	}
+	defer f.Close()
+	table, err := utils.ReadTable(format, f, maxImportRows)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": err.Error(),
+		})
+		return
+	}
+
+	rows, errs, err := parseGoodsTable(table)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": err.Error(),
+		})
+		return
+	}
+	batches := importBatches(rows)
+
+	// 先检查所有的批次，避免保存了一部分之后才发现后面的行有错误
+	var created, updated int32
+	for _, batch := range batches {
+		rsp, err := importGoods(batch, true)
+		if err != nil {
+			zap.S().Errorf("[Import] 检查导入的商品失败: %s", err.Error())
+			HandleGrpcErrorToHttp(err, ctx)
+			return
+		}
+		for _, e := range rsp.Errors {
+			errs = append(errs, importError{Line: e.Line, Msg: e.Msg})
+		}
+		created += rsp.Created
+		updated += rsp.Updated
+	}
+	if len(errs) > 0 {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg":    "文件中有错误的行，没有导入任何商品",
+			"errors": errs,
+		})
+		return
+	}
+	if dryRun {
+		ctx.JSON(http.StatusOK, gin.H{
+			"dry_run": true,
+			"total":   len(rows),
+			"created": created,
+			"updated": updated,
+		})
+		return
+	}
+
+	created, updated = 0, 0
+	var stockErrs []importError
+	for _, batch := range batches {
+		rsp, err := importGoods(batch, false)
+		if err == nil && len(rsp.Errors) > 0 {
+			// 检查之后数据被修改了，例如分类被删除
+			err = fmt.Errorf("第%d行: %s", rsp.Errors[0].Line, rsp.Errors[0].Msg)
+		}
+		if err != nil {
+			zap.S().Errorf("[Import] 导入商品失败: %s", err.Error())
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"msg":     fmt.Sprintf("从第%d行开始的商品导入失败，之前的商品已经导入", batch[0].row.Line),
+				"created": created,
+				"updated": updated,
+			})
+			return
+		}
+		created += rsp.Created
+		updated += rsp.Updated
+		stockErrs = append(stockErrs, setImportStocks(batch, rsp.Goods)...)
+	}
+	result := gin.H{
+		"total":   len(rows),
+		"created": created,
+		"updated": updated,
+	}
+	if len(stockErrs) > 0 {
+		result["stock_errors"] = stockErrs
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+func importGoods(batch []importRow, dryRun bool) (*proto.ImportGoodsResponse, error) {
+	req := &proto.ImportGoodsRequest{DryRun: dryRun}
+	for _, r := range batch {
+		req.Rows = append(req.Rows, r.row)
+	}
+	return global.GoodsSrvClient.ImportGoods(context.Background(), req)
+}
+
+// setImportStocks 导入之后设置库存，商品和库存在两个服务中，设置失败时返回每一行的错误
+func setImportStocks(batch []importRow, results []*proto.ImportGoodsResult) []importError {
+	stocks := make(map[int32]*int32, len(batch))
+	for _, r := range batch {
+		stocks[r.row.Line] = r.stocks
+	}
+	var errs []importError
+	for _, result := range results {
+		num := stocks[result.Line]
+		if num == nil {
+			continue
+		}
+		if _, err := global.InventorySrvClient.SetInv(context.Background(), &proto.GoodsInvInfo{
+			GoodsId: result.GoodsId,
+			Num:     *num,
+		}); err != nil {
+			zap.S().Errorf("[Import] 商品 %d 设置库存失败: %s", result.GoodsId, err.Error())
+			errs = append(errs, importError{Line: result.Line, Msg: "设置库存失败"})
+		}
+	}
+	return errs
+}
+
+// importBatches 按照行数和消息大小分批
+func importBatches(rows []importRow) [][]importRow {
+	var batches [][]importRow
+	var batch []importRow
+	size := 0
+	for _, r := range rows {
+		rowSize := gproto.Size(r.row)
+		if len(batch) > 0 && (len(batch) >= importBatch || size+rowSize > importBatchLen) {
+			batches = append(batches, batch)
+			batch, size = nil, 0
+		}
+		batch = append(batch, r)
+		size += rowSize
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// parseGoodsTable 按照表头把每一行转换成ImportGoodsRow
+// 格式不正确的行返回行号和错误，文件中重复的商品编号也在这里检查，因为会分到不同的批次中
+func parseGoodsTable(table [][]string) ([]importRow, []importError, error) {
+	if len(table) < 2 {
+		return nil, nil, fmt.Errorf("文件中没有商品")
+	}
+	index := make(map[string]int, len(table[0]))
+	for i, name := range table[0] {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range goodsColumns {
+		if _, ok := index[name]; !ok && name != "stocks" {
+			return nil, nil, fmt.Errorf("缺少%s列", name)
+		}
+	}
+
+	var rows []importRow
+	var errs []importError
+	seen := make(map[string]int32)
+	for i, cells := range table[1:] {
+		line := int32(i + 2)
+		if cells == nil {
+			continue
+		}
+		cell := func(name string) string {
+			if i, ok := index[name]; ok && i < len(cells) {
+				return strings.TrimSpace(cells[i])
+			}
+			return ""
+		}
+		r, err := parseGoodsRow(line, cell)
+		if err != nil {
+			errs = append(errs, importError{Line: line, Msg: err.Error()})
+			continue
+		}
+		if first, ok := seen[r.row.Goods.GoodsSn]; ok && r.row.Goods.GoodsSn != "" {
+			errs = append(errs, importError{Line: line, Msg: fmt.Sprintf("商品编号和第%d行重复", first)})
+			continue
+		}
+		seen[r.row.Goods.GoodsSn] = line
+		rows = append(rows, r)
+	}
+	if len(rows) == 0 && len(errs) == 0 {
+		return nil, nil, fmt.Errorf("文件中没有商品")
+	}
+	return rows, errs, nil
+}
+
+func parseGoodsRow(line int32, cell func(name string) string) (importRow, error) {
+	goods := &proto.CreateGoodsInfo{
+		GoodsSn:         cell("goods_sn"),
+		Name:            cell("name"),
+		GoodsBrief:      cell("goods_brief"),
+		GoodsFrontImage: cell("front_image"),
+		Images:          splitImages(cell("images")),
+		DescImages:      splitImages(cell("desc_images")),
+		GoodsDesc:       cell("goods_desc"),
+	}
+	var err error
+	if goods.MarketPrice, err = parsePrice(cell("market_price")); err != nil {
+		return importRow{}, fmt.Errorf("market_price不是正确的价格")
+	}
+	if goods.ShopPrice, err = parsePrice(cell("shop_price")); err != nil {
+		return importRow{}, fmt.Errorf("shop_price不是正确的价格")
+	}
+	for name, value := range map[string]*bool{
+		"ship_free": &goods.ShipFree,
+		"is_new":    &goods.IsNew,
+		"is_hot":    &goods.IsHot,
+		"on_sale":   &goods.OnSale,
+	} {
+		if *value, err = parseBool(cell(name)); err != nil {
+			return importRow{}, fmt.Errorf("%s只能填是或者否", name)
+		}
+	}
+	r := importRow{row: &proto.ImportGoodsRow{
+		Line:     line,
+		Category: cell("category"),
+		Brand:    cell("brand"),
+		Goods:    goods,
+	}}
+	if value := cell("stocks"); value != "" {
+		num, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || num < 0 {
+			return importRow{}, fmt.Errorf("stocks不是正确的库存数量")
+		}
+		goods.Stocks = int32(num)
+		r.stocks = &goods.Stocks
+	}
+	return r, nil
+}
+
+func parsePrice(value string) (float32, error) {
+	if value == "" {
+		return 0, nil
+	}
+	price, err := strconv.ParseFloat(value, 32)
+	if err != nil || price < 0 {
+		return 0, fmt.Errorf("价格不正确: %s", value)
+	}
+	return float32(price), nil
+}
+
+func parseBool(value string) (bool, error) {
+	switch strings.ToLower(value) {
+	case "", "0", "false", "n", "no", "否":
+		return false, nil
+	case "1", "true", "y", "yes", "是":
+		return true, nil
+	}
+	return false, fmt.Errorf("不是布尔值: %s", value)
+}
+
+func splitImages(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return r == '|' || r == '\n' || r == '\r'
+	})
+}
+
+func formatBool(value bool) string {
+	if value {
+		return "是"
+	}
+	return "否"
+}
+
+// Export 按照商品列表的筛选条件导出商品，format=csv或者xlsx
+// 分页查询商品，csv每一页查询之后就发送出去，大量商品时不需要全部放在内存中
+// 默认导出所有的商品，on_sale=true只导出上架的商品
+func Export(ctx *gin.Context) {
+	filterForm := forms.GoodsFilterForm{}
+	if err := ctx.ShouldBindQuery(&filterForm); err != nil {
+		HandleValidatorError(ctx, err)
+		return
+	}
+	format := ctx.DefaultQuery("format", utils.TableCSV)
+	if format != utils.TableCSV && format != utils.TableXLSX {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "只支持导出csv和xlsx文件",
+		})
+		return
+	}
+	request := &proto.GoodsFilterRequest{
+		KeyWords:    filterForm.Keywords,
+		PriceMin:    filterForm.PriceMin,
+		PriceMax:    filterForm.PriceMax,
+		IsHot:       filterForm.IsHot,
+		IsNew:       filterForm.IsNew,
+		IsTab:       filterForm.IsTab,
+		OnSale:      filterForm.OnSale != nil && *filterForm.OnSale,
+		Categories:  filterForm.Categories,
+		Brands:      filterForm.Brands,
+		Sort:        goodsSorts[filterForm.Sort],
+		PagePerNums: exportPageSize,
+	}
+
+	categories, err := global.GoodsSrvClient.GetAllCategorysList(context.Background(), &empty.Empty{})
+	if err != nil {
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	paths := categoryPaths(categories.Tree, "", make(map[int32]string))
+
+	// 第一页查询成功之后再开始写文件，查询失败时还可以返回错误的状态码
+	request.Pages = 1
+	page, err := global.GoodsSrvClient.GoodsList(context.Background(), request)
+	if err != nil {
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	filename := fmt.Sprintf("goods_%s.%s", time.Now().Format("20060102150405"), format)
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	if format == utils.TableCSV {
+		ctx.Header("Content-Type", "text/csv; charset=utf-8")
+	} else {
+		ctx.Header("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	}
+	ctx.Status(http.StatusOK)
+
+	w, err := utils.NewTableWriter(format, ctx.Writer)
+	if err != nil {
+		zap.S().Errorf("[Export] 创建导出文件失败: %s", err.Error())
+		return
+	}
+	if err := w.Write(goodsColumns); err != nil {
+		zap.S().Errorf("[Export] 导出商品失败: %s", err.Error())
+		return
+	}
+	for written := int32(0); ; {
+		for _, goods := range page.Data {
+			if err := w.Write(goodsToRow(goods, paths)); err != nil {
+				zap.S().Errorf("[Export] 导出商品失败: %s", err.Error())
+				return
+			}
+		}
+		if err := w.Flush(); err != nil {
+			zap.S().Errorf("[Export] 导出商品失败: %s", err.Error())
+			return
+		}
+		ctx.Writer.Flush()
+		written += int32(len(page.Data))
+		if len(page.Data) < exportPageSize || written >= page.Total {
+			break
+		}
+		request.Pages++
+		if page, err = global.GoodsSrvClient.GoodsList(context.Background(), request); err != nil {
+			// 已经开始发送文件了，只能中断，下载的文件不完整
+			zap.S().Errorf("[Export] 查询第%d页商品失败: %s", request.Pages, err.Error())
+			return
+		}
+	}
+	if err := w.Close(); err != nil {
+		zap.S().Errorf("[Export] 导出商品失败: %s", err.Error())
+	}
+}
+
+// categoryPaths 分类id对应的完整路径
+func categoryPaths(tree []*proto.CategoryTree, prefix string, paths map[int32]string) map[int32]string {
+	for _, node := range tree {
+		path := node.Name
+		if prefix != "" {
+			path = prefix + "/" + node.Name
+		}
+		paths[node.Id] = path
+		categoryPaths(node.SubCategorys, path, paths)
+	}
+	return paths
+}
+
+// goodsToRow 和goodsColumns的顺序一致，库存在库存服务中，导出时为空
+func goodsToRow(goods *proto.GoodsInfoResponse, paths map[int32]string) []string {
+	category := paths[goods.CategoryId]
+	if category == "" && goods.Category != nil {
+		category = goods.Category.Name
+	}
+	brand := ""
+	if goods.Brand != nil {
+		brand = goods.Brand.Name
+	}
+	return []string{
+		goods.GoodsSn,
+		goods.Name,
+		category,
+		brand,
+		strconv.FormatFloat(float64(goods.MarketPrice), 'f', -1, 32),
+		strconv.FormatFloat(float64(goods.ShopPrice), 'f', -1, 32),
+		"",
+		goods.GoodsBrief,
+		formatBool(goods.ShipFree),
+		formatBool(goods.IsNew),
+		formatBool(goods.IsHot),
+		formatBool(goods.OnSale),
+		goods.GoodsFrontImage,
+		strings.Join(goods.Images, "|"),
+		strings.Join(goods.DescImages, "|"),
+		goods.GoodsDesc,
+	}
+}
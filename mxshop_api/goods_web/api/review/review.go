@@ -0,0 +1,215 @@
+package review
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_api/goods_web/api"
+	"mxshop_api/goods_web/forms"
+	"mxshop_api/goods_web/global"
+	"mxshop_api/goods_web/proto"
+)
+
+// reviewStatus 接口中使用的审核状态和proto的对应关系
+var reviewStatus = map[string]proto.ReviewStatus{
+	"pending":  proto.ReviewStatus_REVIEW_PENDING,
+	"approved": proto.ReviewStatus_REVIEW_APPROVED,
+	"rejected": proto.ReviewStatus_REVIEW_REJECTED,
+}
+
+func statusName(s proto.ReviewStatus) string {
+	for name, value := range reviewStatus {
+		if value == s {
+			return name
+		}
+	}
+	return ""
+}
+
+func currentUserId(ctx *gin.Context) int32 {
+	userId, _ := ctx.Get("userId")
+	return int32(userId.(uint))
+}
+
+func reviewToMap(review *proto.ReviewResponse) map[string]interface{} {
+	images := review.Images
+	if images == nil {
+		images = []string{}
+	}
+	return map[string]interface{}{
+		"id":          review.Id,
+		"user_id":     review.UserId,
+		"goods_id":    review.GoodsId,
+		"order_goods": review.OrderGoodsId,
+		"rating":      review.Rating,
+		"content":     review.Content,
+		"images":      images,
+		"sku_specs":   review.SkuSpecs,
+		"reply":       review.Reply,
+		"reply_time":  review.ReplyTime,
+		"status":      statusName(review.Status),
+		"helpful_num": review.HelpfulNum,
+		"add_time":    review.AddTime,
+	}
+}
+
+func reviewId(ctx *gin.Context) (int32, bool) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return 0, false
+	}
+	return int32(id), true
+}
+
+// list 查询评价列表，userId为0时不按照用户过滤
+func list(ctx *gin.Context, req *proto.ReviewFilterRequest) {
+	filterForm := forms.ReviewFilterForm{}
+	if err := ctx.ShouldBindQuery(&filterForm); err != nil {
+		api.HandleValidatorErr(ctx, err)
+		return
+	}
+	req.Sort = filterForm.Sort
+	req.Pages = filterForm.Page
+	req.PagePerNums = filterForm.PageSize
+	if req.GoodsId == 0 {
+		req.GoodsId = filterForm.Goods
+	}
+	if req.Status == proto.ReviewStatus_REVIEW_STATUS_UNSPECIFIED {
+		req.Status = reviewStatus[filterForm.Status]
+	}
+	rsp, err := global.GoodsSrvClient.ReviewList(context.Background(), req)
+	if err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	data := make([]interface{}, 0, len(rsp.Data))
+	for _, review := range rsp.Data {
+		data = append(data, reviewToMap(review))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"total": rsp.Total,
+		"data":  data,
+	})
+}
+
+// GoodsReviews 商品详情页的评价，只返回审核通过的评价，sort=newest|helpful
+func GoodsReviews(ctx *gin.Context) {
+	goodsId, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil || goodsId <= 0 {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+	list(ctx, &proto.ReviewFilterRequest{
+		GoodsId: int32(goodsId),
+		Status:  proto.ReviewStatus_REVIEW_APPROVED,
+	})
+}
+
+// MyReviews 当前用户的评价，包括还没有审核的评价
+func MyReviews(ctx *gin.Context) {
+	list(ctx, &proto.ReviewFilterRequest{
+		UserId: currentUserId(ctx),
+	})
+}
+
+// List 后台查询评价，可以按照商品和审核状态过滤
+func List(ctx *gin.Context) {
+	list(ctx, &proto.ReviewFilterRequest{})
+}
+
+// New 评价订单中的一件商品，订单完成之后才能评价，每件商品只能评价一次
+func New(ctx *gin.Context) {
+	reviewForm := forms.ReviewForm{}
+	if err := ctx.ShouldBindJSON(&reviewForm); err != nil {
+		api.HandleValidatorErr(ctx, err)
+		return
+	}
+	rsp, err := global.GoodsSrvClient.CreateReview(context.Background(), &proto.ReviewRequest{
+		UserId:       currentUserId(ctx),
+		OrderGoodsId: reviewForm.OrderGoodsId,
+		Rating:       reviewForm.Rating,
+		Content:      reviewForm.Content,
+		Images:       reviewForm.Images,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.AlreadyExists, codes.FailedPrecondition, codes.InvalidArgument:
+			// 已经评价过、订单还没有完成等，把原因返回给用户
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"msg": status.Convert(err).Message(),
+			})
+		default:
+			api.HandleGrpcErrToHttp(err, ctx)
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, reviewToMap(rsp))
+}
+
+// Helpful 标记评价有用，重复标记不报错
+func Helpful(ctx *gin.Context) {
+	id, ok := reviewId(ctx)
+	if !ok {
+		return
+	}
+	if _, err := global.GoodsSrvClient.HelpfulReview(context.Background(), &proto.ReviewHelpfulRequest{
+		Id:     id,
+		UserId: currentUserId(ctx),
+	}); err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+// UpdateStatus 审核评价
+func UpdateStatus(ctx *gin.Context) {
+	id, ok := reviewId(ctx)
+	if !ok {
+		return
+	}
+	statusForm := forms.ReviewStatusForm{}
+	if err := ctx.ShouldBindJSON(&statusForm); err != nil {
+		api.HandleValidatorErr(ctx, err)
+		return
+	}
+	if _, err := global.GoodsSrvClient.ModerateReview(context.Background(), &proto.ReviewModerateRequest{
+		Id:     id,
+		Status: reviewStatus[statusForm.Status],
+	}); err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"msg": "修改成功",
+	})
+}
+
+// Reply 商家回复评价，再次回复会覆盖之前的回复
+func Reply(ctx *gin.Context) {
+	id, ok := reviewId(ctx)
+	if !ok {
+		return
+	}
+	replyForm := forms.ReviewReplyForm{}
+	if err := ctx.ShouldBindJSON(&replyForm); err != nil {
+		api.HandleValidatorErr(ctx, err)
+		return
+	}
+	if _, err := global.GoodsSrvClient.ReplyReview(context.Background(), &proto.ReviewReplyRequest{
+		Id:    id,
+		Reply: replyForm.Reply,
+	}); err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"msg": "回复成功",
+	})
+}
@@ -0,0 +1,196 @@
+package upload
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"mxshop_api/goods_web/global"
+	"mxshop_api/goods_web/storage"
+	"mxshop_api/goods_web/utils"
+)
+
+const maxPixels = 50000000 // 图片最多5000万像素
+
+// Upload 上传商品图片、品牌logo和轮播图，multipart表单的file字段
+// 文件按照内容的sha256保存，同样的图片只保存一份
+// 返回的url保存到商品、品牌和轮播图中，signed_url是带有效期的访问地址
+func Upload(ctx *gin.Context) {
+	cfg := global.ServerConfig.StorageInfo
+	maxSize := int64(cfg.MaxSize) << 20
+	file, err := ctx.FormFile("file")
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "请选择上传的文件",
+		})
+		return
+	}
+	if file.Size > maxSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": fmt.Sprintf("文件不能超过%dMB", cfg.MaxSize),
+		})
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "读取文件失败",
+		})
+		return
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, maxSize+1))
+	if err != nil || int64(len(data)) > maxSize {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "读取文件失败",
+		})
+		return
+	}
+
+	contentType, ext, err := utils.DetectImage(data)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": err.Error(),
+		})
+		return
+	}
+	img, err := utils.DecodeImage(data, maxPixels)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": err.Error(),
+		})
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+	key := fmt.Sprintf("images/%s/%s.%s", hash[:2], hash, ext)
+	existed, err := save(ctx, key, contentType, func() ([]byte, error) { return data, nil })
+	if err != nil {
+		zap.S().Errorf("[Upload] 保存文件 %s 失败: %s", key, err.Error())
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"msg": "保存文件失败",
+		})
+		return
+	}
+
+	// 缩略图固定使用jpg格式，比原图宽的缩略图不生成
+	width := img.Bounds().Dx()
+	thumbnails := make(map[string]interface{})
+	for _, w := range cfg.Thumbnails {
+		if w <= 0 || w >= width {
+			continue
+		}
+		thumbKey := fmt.Sprintf("thumbs/%s/%s_%d.jpg", hash[:2], hash, w)
+		if _, err := save(ctx, thumbKey, "image/jpeg", func() ([]byte, error) { return utils.Thumbnail(img, w) }); err != nil {
+			zap.S().Errorf("[Upload] 保存缩略图 %s 失败: %s", thumbKey, err.Error())
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"msg": "保存缩略图失败",
+			})
+			return
+		}
+		thumbnails[strconv.Itoa(w)] = urls(thumbKey)
+	}
+
+	rsp := urls(key)
+	rsp["key"] = key
+	rsp["hash"] = hash
+	rsp["size"] = len(data)
+	rsp["content_type"] = contentType
+	rsp["width"] = width
+	rsp["height"] = img.Bounds().Dy()
+	rsp["existed"] = existed
+	rsp["thumbnails"] = thumbnails
+	ctx.JSON(http.StatusOK, rsp)
+}
+
+// save 文件不存在的时候才生成内容并保存，返回文件是否已经存在
+func save(ctx context.Context, key, contentType string, content func() ([]byte, error)) (bool, error) {
+	exists, err := global.Storage.Exists(ctx, key)
+	if err != nil || exists {
+		return exists, err
+	}
+	data, err := content()
+	if err != nil {
+		return false, err
+	}
+	return false, global.Storage.Put(ctx, key, data, contentType)
+}
+
+// urls 文件的公开地址和签名地址
+func urls(key string) gin.H {
+	expire := time.Duration(global.ServerConfig.StorageInfo.Expire) * time.Second
+	rsp := gin.H{"url": global.Storage.URL(key)}
+	if signed, err := global.Storage.SignedURL(key, expire); err == nil {
+		rsp["signed_url"] = signed
+		rsp["expires_at"] = time.Now().Add(expire).Unix()
+	}
+	return rsp
+}
+
+// validKey 只能访问上传接口生成的文件
+func validKey(key string) bool {
+	return (strings.HasPrefix(key, "images/") || strings.HasPrefix(key, "thumbs/")) && !strings.Contains(key, "..")
+}
+
+// Sign 重新获取文件的签名地址，查询参数key是上传时返回的key
+func Sign(ctx *gin.Context) {
+	key := ctx.Query("key")
+	if !validKey(key) {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "文件路径不合法",
+		})
+		return
+	}
+	exists, err := global.Storage.Exists(ctx, key)
+	if err != nil {
+		zap.S().Errorf("[Sign] 查询文件 %s 失败: %s", key, err.Error())
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"msg": "查询文件失败",
+		})
+		return
+	}
+	if !exists {
+		ctx.JSON(http.StatusNotFound, gin.H{
+			"msg": "文件不存在",
+		})
+		return
+	}
+	ctx.JSON(http.StatusOK, urls(key))
+}
+
+// File 本地存储的文件下载，带签名的地址检查签名和有效期，私有的存储只能通过签名地址访问
+func File(ctx *gin.Context) {
+	local, ok := global.Storage.(*storage.Local)
+	key := strings.TrimPrefix(ctx.Param("key"), "/")
+	if !ok || !validKey(key) {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+	sign := ctx.Query("sign")
+	if sign != "" || local.Private {
+		if !local.Verify(key, ctx.Query("expires"), sign, time.Now()) {
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"msg": "访问地址已经过期",
+			})
+			return
+		}
+	}
+	p, err := local.Path(key)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+	// 文件名就是内容的hash，内容不会变化
+	ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+	ctx.File(p)
+}
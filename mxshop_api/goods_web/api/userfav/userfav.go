@@ -0,0 +1,146 @@
+package userfav
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"mxshop_api/goods_web/api"
+	"mxshop_api/goods_web/forms"
+	"mxshop_api/goods_web/global"
+	"mxshop_api/goods_web/proto"
+)
+
+func currentUserId(ctx *gin.Context) int32 {
+	userId, _ := ctx.Get("userId")
+	return int32(userId.(uint))
+}
+
+// List 当前用户收藏的商品，最近收藏的在前面
+func List(ctx *gin.Context) {
+	page, _ := strconv.Atoi(ctx.DefaultQuery("page", "0"))
+	pageSize, _ := strconv.Atoi(ctx.DefaultQuery("page_size", "0"))
+	rsp, err := global.GoodsSrvClient.UserFavList(context.Background(), &proto.UserFavRequest{
+		UserId:      currentUserId(ctx),
+		Pages:       int32(page),
+		PagePerNums: int32(pageSize),
+	})
+	if err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	data := make([]interface{}, 0, len(rsp.Data))
+	for _, fav := range rsp.Data {
+		data = append(data, map[string]interface{}{
+			"goods_id":    fav.GoodsId,
+			"add_time":    fav.AddTime,
+			"name":        fav.Goods.Name,
+			"shop_price":  fav.Goods.ShopPrice,
+			"front_image": fav.Goods.GoodsFrontImage,
+			"on_sale":     fav.Goods.OnSale,
+			"fav_num":     fav.Goods.FavNum,
+		})
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"total": rsp.Total,
+		"data":  data,
+	})
+}
+
+// New 收藏商品，重复收藏不会报错
+func New(ctx *gin.Context) {
+	userFavForm := forms.UserFavForm{}
+	if err := ctx.ShouldBindJSON(&userFavForm); err != nil {
+		api.HandleValidatorErr(ctx, err)
+		return
+	}
+	if _, err := global.GoodsSrvClient.AddUserFav(context.Background(), &proto.UserFavRequest{
+		UserId:  currentUserId(ctx),
+		GoodsId: userFavForm.GoodsId,
+	}); err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+// Delete 取消收藏，id是商品的id
+func Delete(ctx *gin.Context) {
+	goodsId, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+	if _, err := global.GoodsSrvClient.DeleteUserFav(context.Background(), &proto.UserFavRequest{
+		UserId:  currentUserId(ctx),
+		GoodsId: int32(goodsId),
+	}); err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+// Detail 是否收藏了这个商品，id是商品的id
+func Detail(ctx *gin.Context) {
+	goodsId, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+	rsp, err := global.GoodsSrvClient.CheckUserFav(context.Background(), &proto.UserFavCheckRequest{
+		UserId:   currentUserId(ctx),
+		GoodsIds: []int32{int32(goodsId)},
+	})
+	if err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"goods_id": goodsId,
+		"is_fav":   len(rsp.GoodsIds) > 0,
+	})
+}
+
+// Check 列表页批量查询哪些商品已经收藏，返回已经收藏的商品id
+func Check(ctx *gin.Context) {
+	checkForm := forms.UserFavCheckForm{}
+	if err := ctx.ShouldBindQuery(&checkForm); err != nil {
+		api.HandleValidatorErr(ctx, err)
+		return
+	}
+	rsp, err := global.GoodsSrvClient.CheckUserFav(context.Background(), &proto.UserFavCheckRequest{
+		UserId:   currentUserId(ctx),
+		GoodsIds: checkForm.GoodsIds,
+	})
+	if err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	goodsIds := rsp.GoodsIds
+	if goodsIds == nil {
+		goodsIds = []int32{}
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"goods_ids": goodsIds,
+	})
+}
+
+// Reconcile 管理员按照收藏记录修正商品的收藏数，不传商品时检查所有的商品
+func Reconcile(ctx *gin.Context) {
+	checkForm := forms.UserFavCheckForm{}
+	_ = ctx.ShouldBindQuery(&checkForm)
+	rsp, err := global.GoodsSrvClient.ReconcileFavNum(context.Background(), &proto.ReconcileFavRequest{
+		GoodsIds: checkForm.GoodsIds,
+	})
+	if err != nil {
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"checked": rsp.Checked,
+		"fixed":   rsp.Fixed,
+	})
+}
@@ -32,8 +32,29 @@ type ServerConfig struct {
 	Tags         []string       `mapstructure:"tags" json:"tags"`
 	Port         int            `mapstructure:"port" json:"port"`
 	GoodsSrvInfo GoodsSrvConfig `mapstructure:"goods_srv" json:"goods_srv"`
-	JWTInfo      JWTConfig      `mapstructure:"jwt" json:"jwt"`
-	ConsulInfo   ConsulConfig   `mapstructure:"consul" json:"consul"`
+	// 库存服务，新建商品时设置库存
+	InventorySrvInfo GoodsSrvConfig `mapstructure:"inventory_srv" json:"inventory_srv"`
+	JWTInfo          JWTConfig      `mapstructure:"jwt" json:"jwt"`
+	ConsulInfo       ConsulConfig   `mapstructure:"consul" json:"consul"`
+	StorageInfo      StorageConfig  `mapstructure:"storage" json:"storage"`
+}
+
+// StorageConfig 上传图片的存储，type为local(默认)或者s3
+type StorageConfig struct {
+	Type       string `mapstructure:"type" json:"type"`
+	Root       string `mapstructure:"root" json:"root"`         // 本地存储的目录
+	BaseUrl    string `mapstructure:"base_url" json:"base_url"` // 对外访问的地址前缀
+	Secret     string `mapstructure:"secret" json:"secret"`     // 本地存储签名地址的密钥
+	Private    bool   `mapstructure:"private" json:"private"`   // 本地存储的文件只能通过签名地址访问
+	Endpoint   string `mapstructure:"endpoint" json:"endpoint"`
+	Region     string `mapstructure:"region" json:"region"`
+	Bucket     string `mapstructure:"bucket" json:"bucket"`
+	AccessKey  string `mapstructure:"access_key" json:"access_key"`
+	SecretKey  string `mapstructure:"secret_key" json:"secret_key"`
+	PathStyle  bool   `mapstructure:"path_style" json:"path_style"`
+	Expire     int    `mapstructure:"expire" json:"expire"`         // 签名地址的有效期，单位秒
+	MaxSize    int    `mapstructure:"max_size" json:"max_size"`     // 上传文件的大小限制，单位MB
+	Thumbnails []int  `mapstructure:"thumbnails" json:"thumbnails"` // 缩略图的宽度
 }
 
 type NacosConfig struct {
@@ -11,3 +11,8 @@ type UpdateCategoryForm struct {
 	Name  string `form:"name" json:"name" binding:"required,min=3,max=20"`
 	IsTab *bool  `form:"is_tab" json:"is_tab"`
 }
+
+// MoveCategoryForm 移动分类，parent为0表示移动成一级分类
+type MoveCategoryForm struct {
+	Parent *int32 `form:"parent" json:"parent" binding:"required,min=0"`
+}
@@ -9,8 +9,9 @@ type GoodsForm struct {
 	MarketPrice float32  `form:"market_price" json:"market_price" binding:"required,min=0"`
 	ShopPrice   float32  `form:"shop_price" json:"shop_price" binding:"required,min=0"`
 	GoodsBrief  string   `form:"goods_brief" json:"goods_brief" binding:"required,min=3"`
-	Images      []string `form:"images" json:"images" binding:"required,min=1"`
-	DescImages  []string `form:"desc_images" json:"desc_images" binding:"required,min=1"`
+	GoodsDesc   string   `form:"goods_desc" json:"goods_desc" binding:"max=60000"` // 商品详情的html，服务端会过滤
+	Images      []string `form:"images" json:"images" binding:"required,min=1,max=10,dive,url,max=200"`
+	DescImages  []string `form:"desc_images" json:"desc_images" binding:"required,min=1,max=30,dive,url,max=200"`
 	ShipFree    *bool    `form:"ship_free" json:"ship_free" binding:"required"`
 	FrontImage  string   `form:"front_image" json:"front_image" binding:"required,url"`
 	Brand       int32    `form:"brand" json:"brand" binding:"required"`
@@ -22,3 +23,31 @@ type GoodsStatusForm struct {
 	IsHot  *bool `form:"hot" json:"hot" binding:"required"`
 	OnSale *bool `form:"sale" json:"sale" binding:"required"`
 }
+
+// GoodsFilterForm 商品列表的查询参数
+type GoodsFilterForm struct {
+	Keywords     string  `form:"q" binding:"max=50"`
+	PriceMin     int32   `form:"price_min" binding:"min=0"`
+	PriceMax     int32   `form:"price_max" binding:"omitempty,gtefield=PriceMin"` // 0表示没有上限
+	IsHot        bool    `form:"is_hot"`
+	IsNew        bool    `form:"is_new"`
+	IsTab        bool    `form:"is_tab"`
+	OnSale       *bool   `form:"on_sale"` // 默认只返回上架的商品，on_sale=false返回所有商品
+	Categories   []int32 `form:"category" binding:"max=20,dive,min=1"`
+	Brands       []int32 `form:"brand" binding:"max=20,dive,min=1"`
+	Sort         string  `form:"sort" binding:"omitempty,oneof=price_asc price_desc sold click newest relevance"`
+	Page         int32   `form:"page" binding:"min=0"`
+	PageSize     int32   `form:"page_size" binding:"min=0,max=100"`
+	Facets       bool    `form:"facets"`
+	PriceBuckets []int32 `form:"price_bucket" binding:"max=10,dive,min=1"` // 价格区间的分界点
+}
+
+// UserFavForm 收藏商品
+type UserFavForm struct {
+	GoodsId int32 `form:"goods" json:"goods" binding:"required,min=1"`
+}
+
+// UserFavCheckForm 列表页批量查询是否收藏：?goods=1&goods=2
+type UserFavCheckForm struct {
+	GoodsIds []int32 `form:"goods" binding:"required,max=100,dive,min=1"`
+}
@@ -0,0 +1,28 @@
+package forms
+
+// ReviewForm 评价订单中的一件商品
+type ReviewForm struct {
+	OrderGoodsId int32    `form:"order_goods" json:"order_goods" binding:"required,min=1"`
+	Rating       int32    `form:"rating" json:"rating" binding:"required,min=1,max=5"`
+	Content      string   `form:"content" json:"content" binding:"max=500"`
+	Images       []string `form:"images" json:"images" binding:"max=9,dive,url,max=200"`
+}
+
+// ReviewFilterForm 评价列表的查询参数，status只有后台可以使用
+type ReviewFilterForm struct {
+	Goods    int32  `form:"goods" binding:"min=0"`
+	Status   string `form:"status" binding:"omitempty,oneof=pending approved rejected"`
+	Sort     string `form:"sort" binding:"omitempty,oneof=newest helpful"`
+	Page     int32  `form:"page" binding:"min=0"`
+	PageSize int32  `form:"page_size" binding:"min=0,max=100"`
+}
+
+// ReviewStatusForm 审核评价
+type ReviewStatusForm struct {
+	Status string `form:"status" json:"status" binding:"required,oneof=pending approved rejected"`
+}
+
+// ReviewReplyForm 商家回复评价
+type ReviewReplyForm struct {
+	Reply string `form:"reply" json:"reply" binding:"required,max=500"`
+}
@@ -4,11 +4,14 @@ import (
 	ut "github.com/go-playground/universal-translator"
 	"mxshop_api/goods_web/config"
 	"mxshop_api/goods_web/proto"
+	"mxshop_api/goods_web/storage"
 )
 
 var (
-	Trans          ut.Translator
-	ServerConfig   *config.ServerConfig = &config.ServerConfig{}
-	NacosConfig    *config.NacosConfig  = &config.NacosConfig{}
-	GoodsSrvClient proto.GoodsClient
-)
\ No newline at end of file
+	Trans              ut.Translator
+	ServerConfig       *config.ServerConfig = &config.ServerConfig{}
+	NacosConfig        *config.NacosConfig  = &config.NacosConfig{}
+	GoodsSrvClient     proto.GoodsClient
+	InventorySrvClient proto.InventoryClient
+	Storage            storage.Storage
+)
@@ -23,5 +23,8 @@ func Routers() *gin.Engine {
 	router.InitCategoryRouter(ApiGroup) //商品分类
 	router.InitBannerRouter(ApiGroup)   //商品轮播图
 	router.InitBrandsRouter(ApiGroup)   //品牌，分类-品牌
+	router.InitUploadRouter(ApiGroup)   //图片上传
+	router.InitUserFavRouter(ApiGroup)  //用户收藏
+	router.InitReviewRouter(ApiGroup)   //商品评价
 	return Router
 }
@@ -22,4 +22,15 @@ func InitSrvConn() {
 	}
 	zap.S().Info("[InitSrvConn] 连接 【用户服务成功】")
 	global.GoodsSrvClient = proto.NewGoodsClient(userConn)
+
+	inventoryConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.InventorySrvInfo.Name),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【库存服务失败】")
+	}
+	zap.S().Info("[InitSrvConn] 连接 【库存服务成功】")
+	global.InventorySrvClient = proto.NewInventoryClient(inventoryConn)
 }
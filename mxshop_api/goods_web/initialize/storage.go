@@ -0,0 +1,45 @@
+package initialize
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"mxshop_api/goods_web/global"
+	"mxshop_api/goods_web/storage"
+)
+
+// InitStorage 根据配置初始化上传图片的存储，需要在确定服务端口之后调用
+func InitStorage() {
+	cfg := &global.ServerConfig.StorageInfo
+	if cfg.Expire <= 0 {
+		cfg.Expire = 3600
+	}
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = 10
+	}
+	if len(cfg.Thumbnails) == 0 {
+		cfg.Thumbnails = []int{200, 400}
+	}
+
+	var err error
+	switch cfg.Type {
+	case "s3":
+		global.Storage, err = storage.NewS3(cfg.Endpoint, cfg.Region, cfg.Bucket, cfg.AccessKey, cfg.SecretKey, cfg.PathStyle, cfg.BaseUrl)
+	case "", "local":
+		root, baseURL := cfg.Root, cfg.BaseUrl
+		if root == "" {
+			root = "upload"
+		}
+		if baseURL == "" {
+			baseURL = fmt.Sprintf("http://%s:%d/g/v1/files", global.ServerConfig.Host, global.ServerConfig.Port)
+		}
+		global.Storage, err = storage.NewLocal(root, baseURL, cfg.Secret, cfg.Private)
+	default:
+		err = fmt.Errorf("不支持的存储类型: %s", cfg.Type)
+	}
+	if err != nil {
+		zap.S().Fatalf("[InitStorage] 初始化 【图片存储】失败: %s", err.Error())
+	}
+	zap.S().Infof("[InitStorage] 图片存储: %s", cfg.Type)
+}
@@ -41,6 +41,10 @@ func main() {
 			global.ServerConfig.Port = port
 		}
 	}
+
+	//6. 初始化图片存储，本地存储的访问地址需要用到端口
+	initialize.InitStorage()
+
 	registerClient := consul.NewRegistryClient(global.ServerConfig.ConsulInfo.Host, global.ServerConfig.ConsulInfo.Port)
 	uuidRsp, _ := uuid.NewV4()
 	serviceId := fmt.Sprintf("%s", uuidRsp)
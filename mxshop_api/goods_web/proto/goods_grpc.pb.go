@@ -26,11 +26,14 @@ const (
 	Goods_DeleteGoods_FullMethodName          = "/Goods/DeleteGoods"
 	Goods_UpdateGoods_FullMethodName          = "/Goods/UpdateGoods"
 	Goods_GetGoodsDetail_FullMethodName       = "/Goods/GetGoodsDetail"
+	Goods_ImportGoods_FullMethodName          = "/Goods/ImportGoods"
 	Goods_GetAllCategorysList_FullMethodName  = "/Goods/GetAllCategorysList"
 	Goods_GetSubCategory_FullMethodName       = "/Goods/GetSubCategory"
 	Goods_CreateCategory_FullMethodName       = "/Goods/CreateCategory"
 	Goods_DeleteCategory_FullMethodName       = "/Goods/DeleteCategory"
 	Goods_UpdateCategory_FullMethodName       = "/Goods/UpdateCategory"
+	Goods_MoveCategory_FullMethodName         = "/Goods/MoveCategory"
+	Goods_CategoryBreadcrumbs_FullMethodName  = "/Goods/CategoryBreadcrumbs"
 	Goods_BrandList_FullMethodName            = "/Goods/BrandList"
 	Goods_CreateBrand_FullMethodName          = "/Goods/CreateBrand"
 	Goods_DeleteBrand_FullMethodName          = "/Goods/DeleteBrand"
@@ -44,6 +47,25 @@ const (
 	Goods_CreateCategoryBrand_FullMethodName  = "/Goods/CreateCategoryBrand"
 	Goods_DeleteCategoryBrand_FullMethodName  = "/Goods/DeleteCategoryBrand"
 	Goods_UpdateCategoryBrand_FullMethodName  = "/Goods/UpdateCategoryBrand"
+	Goods_SpecTemplateList_FullMethodName     = "/Goods/SpecTemplateList"
+	Goods_CreateSpecTemplate_FullMethodName   = "/Goods/CreateSpecTemplate"
+	Goods_UpdateSpecTemplate_FullMethodName   = "/Goods/UpdateSpecTemplate"
+	Goods_DeleteSpecTemplate_FullMethodName   = "/Goods/DeleteSpecTemplate"
+	Goods_SkuList_FullMethodName              = "/Goods/SkuList"
+	Goods_BatchGetSku_FullMethodName          = "/Goods/BatchGetSku"
+	Goods_CreateSku_FullMethodName            = "/Goods/CreateSku"
+	Goods_UpdateSku_FullMethodName            = "/Goods/UpdateSku"
+	Goods_DeleteSku_FullMethodName            = "/Goods/DeleteSku"
+	Goods_UserFavList_FullMethodName          = "/Goods/UserFavList"
+	Goods_AddUserFav_FullMethodName           = "/Goods/AddUserFav"
+	Goods_DeleteUserFav_FullMethodName        = "/Goods/DeleteUserFav"
+	Goods_CheckUserFav_FullMethodName         = "/Goods/CheckUserFav"
+	Goods_ReconcileFavNum_FullMethodName      = "/Goods/ReconcileFavNum"
+	Goods_ReviewList_FullMethodName           = "/Goods/ReviewList"
+	Goods_CreateReview_FullMethodName         = "/Goods/CreateReview"
+	Goods_ReplyReview_FullMethodName          = "/Goods/ReplyReview"
+	Goods_ModerateReview_FullMethodName       = "/Goods/ModerateReview"
+	Goods_HelpfulReview_FullMethodName        = "/Goods/HelpfulReview"
 )
 
 // GoodsClient is the client API for Goods service.
@@ -58,12 +80,15 @@ type GoodsClient interface {
 	DeleteGoods(ctx context.Context, in *DeleteGoodsInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateGoods(ctx context.Context, in *CreateGoodsInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*GoodsInfoResponse, error)
+	ImportGoods(ctx context.Context, in *ImportGoodsRequest, opts ...grpc.CallOption) (*ImportGoodsResponse, error)
 	// 商品分类
 	GetAllCategorysList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryListResponse, error)
 	GetSubCategory(ctx context.Context, in *CategoryListRequest, opts ...grpc.CallOption) (*SubCategoryListResponse, error)
 	CreateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*CategoryInfoResponse, error)
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateCategory(ctx context.Context, in *CategoryInfoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CategoryBreadcrumbs(ctx context.Context, in *BreadcrumbsRequest, opts ...grpc.CallOption) (*BreadcrumbsResponse, error)
 	// 品牌和轮播图
 	BrandList(ctx context.Context, in *BrandFilterRequest, opts ...grpc.CallOption) (*BrandListResponse, error)
 	CreateBrand(ctx context.Context, in *BrandRequest, opts ...grpc.CallOption) (*BrandInfoResponse, error)
@@ -80,6 +105,29 @@ type GoodsClient interface {
 	CreateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*CategoryBrandResponse, error)
 	DeleteCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateCategoryBrand(ctx context.Context, in *CategoryBrandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 规格模板，每个分类的商品使用相同的规格
+	SpecTemplateList(ctx context.Context, in *SpecTemplateFilter, opts ...grpc.CallOption) (*SpecTemplateListResponse, error)
+	CreateSpecTemplate(ctx context.Context, in *SpecTemplateInfo, opts ...grpc.CallOption) (*SpecTemplateInfo, error)
+	UpdateSpecTemplate(ctx context.Context, in *SpecTemplateInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteSpecTemplate(ctx context.Context, in *SpecTemplateInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SKU，商品的每种规格组合
+	SkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*SkuListResponse, error)
+	BatchGetSku(ctx context.Context, in *BatchSkuIdInfo, opts ...grpc.CallOption) (*SkuListResponse, error)
+	CreateSku(ctx context.Context, in *SkuInfo, opts ...grpc.CallOption) (*SkuInfo, error)
+	UpdateSku(ctx context.Context, in *SkuInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteSku(ctx context.Context, in *SkuInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 用户收藏，收藏和取消收藏时同时修改商品的收藏数
+	UserFavList(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*UserFavListResponse, error)
+	AddUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CheckUserFav(ctx context.Context, in *UserFavCheckRequest, opts ...grpc.CallOption) (*UserFavCheckResponse, error)
+	ReconcileFavNum(ctx context.Context, in *ReconcileFavRequest, opts ...grpc.CallOption) (*ReconcileFavResponse, error)
+	// 商品评价，审核通过的评价计入商品的评分和评价数
+	ReviewList(ctx context.Context, in *ReviewFilterRequest, opts ...grpc.CallOption) (*ReviewListResponse, error)
+	CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	ReplyReview(ctx context.Context, in *ReviewReplyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ModerateReview(ctx context.Context, in *ReviewModerateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	HelpfulReview(ctx context.Context, in *ReviewHelpfulRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type goodsClient struct {
@@ -150,6 +198,16 @@ func (c *goodsClient) GetGoodsDetail(ctx context.Context, in *GoodInfoRequest, o
 	return out, nil
 }
 
+func (c *goodsClient) ImportGoods(ctx context.Context, in *ImportGoodsRequest, opts ...grpc.CallOption) (*ImportGoodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportGoodsResponse)
+	err := c.cc.Invoke(ctx, Goods_ImportGoods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) GetAllCategorysList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CategoryListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CategoryListResponse)
@@ -200,6 +258,26 @@ func (c *goodsClient) UpdateCategory(ctx context.Context, in *CategoryInfoReques
 	return out, nil
 }
 
+func (c *goodsClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CategoryBreadcrumbs(ctx context.Context, in *BreadcrumbsRequest, opts ...grpc.CallOption) (*BreadcrumbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BreadcrumbsResponse)
+	err := c.cc.Invoke(ctx, Goods_CategoryBreadcrumbs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goodsClient) BrandList(ctx context.Context, in *BrandFilterRequest, opts ...grpc.CallOption) (*BrandListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BrandListResponse)
@@ -330,6 +408,196 @@ func (c *goodsClient) UpdateCategoryBrand(ctx context.Context, in *CategoryBrand
 	return out, nil
 }
 
+func (c *goodsClient) SpecTemplateList(ctx context.Context, in *SpecTemplateFilter, opts ...grpc.CallOption) (*SpecTemplateListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpecTemplateListResponse)
+	err := c.cc.Invoke(ctx, Goods_SpecTemplateList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateSpecTemplate(ctx context.Context, in *SpecTemplateInfo, opts ...grpc.CallOption) (*SpecTemplateInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpecTemplateInfo)
+	err := c.cc.Invoke(ctx, Goods_CreateSpecTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateSpecTemplate(ctx context.Context, in *SpecTemplateInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateSpecTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteSpecTemplate(ctx context.Context, in *SpecTemplateInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteSpecTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) SkuList(ctx context.Context, in *GoodInfoRequest, opts ...grpc.CallOption) (*SkuListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuListResponse)
+	err := c.cc.Invoke(ctx, Goods_SkuList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) BatchGetSku(ctx context.Context, in *BatchSkuIdInfo, opts ...grpc.CallOption) (*SkuListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuListResponse)
+	err := c.cc.Invoke(ctx, Goods_BatchGetSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateSku(ctx context.Context, in *SkuInfo, opts ...grpc.CallOption) (*SkuInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SkuInfo)
+	err := c.cc.Invoke(ctx, Goods_CreateSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UpdateSku(ctx context.Context, in *SkuInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_UpdateSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteSku(ctx context.Context, in *SkuInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteSku_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) UserFavList(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*UserFavListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserFavListResponse)
+	err := c.cc.Invoke(ctx, Goods_UserFavList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) AddUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_AddUserFav_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) DeleteUserFav(ctx context.Context, in *UserFavRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_DeleteUserFav_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CheckUserFav(ctx context.Context, in *UserFavCheckRequest, opts ...grpc.CallOption) (*UserFavCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserFavCheckResponse)
+	err := c.cc.Invoke(ctx, Goods_CheckUserFav_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ReconcileFavNum(ctx context.Context, in *ReconcileFavRequest, opts ...grpc.CallOption) (*ReconcileFavResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconcileFavResponse)
+	err := c.cc.Invoke(ctx, Goods_ReconcileFavNum_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ReviewList(ctx context.Context, in *ReviewFilterRequest, opts ...grpc.CallOption) (*ReviewListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewListResponse)
+	err := c.cc.Invoke(ctx, Goods_ReviewList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) CreateReview(ctx context.Context, in *ReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, Goods_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ReplyReview(ctx context.Context, in *ReviewReplyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_ReplyReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) ModerateReview(ctx context.Context, in *ReviewModerateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsClient) HelpfulReview(ctx context.Context, in *ReviewHelpfulRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Goods_HelpfulReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoodsServer is the server API for Goods service.
 // All implementations must embed UnimplementedGoodsServer
 // for forward compatibility.
@@ -342,12 +610,15 @@ type GoodsServer interface {
 	DeleteGoods(context.Context, *DeleteGoodsInfo) (*emptypb.Empty, error)
 	UpdateGoods(context.Context, *CreateGoodsInfo) (*emptypb.Empty, error)
 	GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error)
+	ImportGoods(context.Context, *ImportGoodsRequest) (*ImportGoodsResponse, error)
 	// 商品分类
 	GetAllCategorysList(context.Context, *emptypb.Empty) (*CategoryListResponse, error)
 	GetSubCategory(context.Context, *CategoryListRequest) (*SubCategoryListResponse, error)
 	CreateCategory(context.Context, *CategoryInfoRequest) (*CategoryInfoResponse, error)
 	DeleteCategory(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
 	UpdateCategory(context.Context, *CategoryInfoRequest) (*emptypb.Empty, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*emptypb.Empty, error)
+	CategoryBreadcrumbs(context.Context, *BreadcrumbsRequest) (*BreadcrumbsResponse, error)
 	// 品牌和轮播图
 	BrandList(context.Context, *BrandFilterRequest) (*BrandListResponse, error)
 	CreateBrand(context.Context, *BrandRequest) (*BrandInfoResponse, error)
@@ -364,6 +635,29 @@ type GoodsServer interface {
 	CreateCategoryBrand(context.Context, *CategoryBrandRequest) (*CategoryBrandResponse, error)
 	DeleteCategoryBrand(context.Context, *CategoryBrandRequest) (*emptypb.Empty, error)
 	UpdateCategoryBrand(context.Context, *CategoryBrandRequest) (*emptypb.Empty, error)
+	// 规格模板，每个分类的商品使用相同的规格
+	SpecTemplateList(context.Context, *SpecTemplateFilter) (*SpecTemplateListResponse, error)
+	CreateSpecTemplate(context.Context, *SpecTemplateInfo) (*SpecTemplateInfo, error)
+	UpdateSpecTemplate(context.Context, *SpecTemplateInfo) (*emptypb.Empty, error)
+	DeleteSpecTemplate(context.Context, *SpecTemplateInfo) (*emptypb.Empty, error)
+	// SKU，商品的每种规格组合
+	SkuList(context.Context, *GoodInfoRequest) (*SkuListResponse, error)
+	BatchGetSku(context.Context, *BatchSkuIdInfo) (*SkuListResponse, error)
+	CreateSku(context.Context, *SkuInfo) (*SkuInfo, error)
+	UpdateSku(context.Context, *SkuInfo) (*emptypb.Empty, error)
+	DeleteSku(context.Context, *SkuInfo) (*emptypb.Empty, error)
+	// 用户收藏，收藏和取消收藏时同时修改商品的收藏数
+	UserFavList(context.Context, *UserFavRequest) (*UserFavListResponse, error)
+	AddUserFav(context.Context, *UserFavRequest) (*emptypb.Empty, error)
+	DeleteUserFav(context.Context, *UserFavRequest) (*emptypb.Empty, error)
+	CheckUserFav(context.Context, *UserFavCheckRequest) (*UserFavCheckResponse, error)
+	ReconcileFavNum(context.Context, *ReconcileFavRequest) (*ReconcileFavResponse, error)
+	// 商品评价，审核通过的评价计入商品的评分和评价数
+	ReviewList(context.Context, *ReviewFilterRequest) (*ReviewListResponse, error)
+	CreateReview(context.Context, *ReviewRequest) (*ReviewResponse, error)
+	ReplyReview(context.Context, *ReviewReplyRequest) (*emptypb.Empty, error)
+	ModerateReview(context.Context, *ReviewModerateRequest) (*emptypb.Empty, error)
+	HelpfulReview(context.Context, *ReviewHelpfulRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedGoodsServer()
 }
 
@@ -392,6 +686,9 @@ func (UnimplementedGoodsServer) UpdateGoods(context.Context, *CreateGoodsInfo) (
 func (UnimplementedGoodsServer) GetGoodsDetail(context.Context, *GoodInfoRequest) (*GoodsInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGoodsDetail not implemented")
 }
+func (UnimplementedGoodsServer) ImportGoods(context.Context, *ImportGoodsRequest) (*ImportGoodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGoods not implemented")
+}
 func (UnimplementedGoodsServer) GetAllCategorysList(context.Context, *emptypb.Empty) (*CategoryListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategorysList not implemented")
 }
@@ -407,6 +704,12 @@ func (UnimplementedGoodsServer) DeleteCategory(context.Context, *DeleteCategoryR
 func (UnimplementedGoodsServer) UpdateCategory(context.Context, *CategoryInfoRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
 }
+func (UnimplementedGoodsServer) MoveCategory(context.Context, *MoveCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedGoodsServer) CategoryBreadcrumbs(context.Context, *BreadcrumbsRequest) (*BreadcrumbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CategoryBreadcrumbs not implemented")
+}
 func (UnimplementedGoodsServer) BrandList(context.Context, *BrandFilterRequest) (*BrandListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BrandList not implemented")
 }
@@ -446,6 +749,63 @@ func (UnimplementedGoodsServer) DeleteCategoryBrand(context.Context, *CategoryBr
 func (UnimplementedGoodsServer) UpdateCategoryBrand(context.Context, *CategoryBrandRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategoryBrand not implemented")
 }
+func (UnimplementedGoodsServer) SpecTemplateList(context.Context, *SpecTemplateFilter) (*SpecTemplateListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpecTemplateList not implemented")
+}
+func (UnimplementedGoodsServer) CreateSpecTemplate(context.Context, *SpecTemplateInfo) (*SpecTemplateInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSpecTemplate not implemented")
+}
+func (UnimplementedGoodsServer) UpdateSpecTemplate(context.Context, *SpecTemplateInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSpecTemplate not implemented")
+}
+func (UnimplementedGoodsServer) DeleteSpecTemplate(context.Context, *SpecTemplateInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSpecTemplate not implemented")
+}
+func (UnimplementedGoodsServer) SkuList(context.Context, *GoodInfoRequest) (*SkuListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SkuList not implemented")
+}
+func (UnimplementedGoodsServer) BatchGetSku(context.Context, *BatchSkuIdInfo) (*SkuListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetSku not implemented")
+}
+func (UnimplementedGoodsServer) CreateSku(context.Context, *SkuInfo) (*SkuInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSku not implemented")
+}
+func (UnimplementedGoodsServer) UpdateSku(context.Context, *SkuInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSku not implemented")
+}
+func (UnimplementedGoodsServer) DeleteSku(context.Context, *SkuInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSku not implemented")
+}
+func (UnimplementedGoodsServer) UserFavList(context.Context, *UserFavRequest) (*UserFavListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserFavList not implemented")
+}
+func (UnimplementedGoodsServer) AddUserFav(context.Context, *UserFavRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserFav not implemented")
+}
+func (UnimplementedGoodsServer) DeleteUserFav(context.Context, *UserFavRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserFav not implemented")
+}
+func (UnimplementedGoodsServer) CheckUserFav(context.Context, *UserFavCheckRequest) (*UserFavCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUserFav not implemented")
+}
+func (UnimplementedGoodsServer) ReconcileFavNum(context.Context, *ReconcileFavRequest) (*ReconcileFavResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileFavNum not implemented")
+}
+func (UnimplementedGoodsServer) ReviewList(context.Context, *ReviewFilterRequest) (*ReviewListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewList not implemented")
+}
+func (UnimplementedGoodsServer) CreateReview(context.Context, *ReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedGoodsServer) ReplyReview(context.Context, *ReviewReplyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyReview not implemented")
+}
+func (UnimplementedGoodsServer) ModerateReview(context.Context, *ReviewModerateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedGoodsServer) HelpfulReview(context.Context, *ReviewHelpfulRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HelpfulReview not implemented")
+}
 func (UnimplementedGoodsServer) mustEmbedUnimplementedGoodsServer() {}
 func (UnimplementedGoodsServer) testEmbeddedByValue()               {}
 
@@ -575,6 +935,24 @@ func _Goods_GetGoodsDetail_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_ImportGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ImportGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ImportGoods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ImportGoods(ctx, req.(*ImportGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_GetAllCategorysList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -665,6 +1043,42 @@ func _Goods_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Goods_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_MoveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CategoryBreadcrumbs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BreadcrumbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CategoryBreadcrumbs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CategoryBreadcrumbs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CategoryBreadcrumbs(ctx, req.(*BreadcrumbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Goods_BrandList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BrandFilterRequest)
 	if err := dec(in); err != nil {
@@ -899,37 +1313,383 @@ func _Goods_UpdateCategoryBrand_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-// Goods_ServiceDesc is the grpc.ServiceDesc for Goods service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Goods_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "Goods",
-	HandlerType: (*GoodsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GoodsList",
-			Handler:    _Goods_GoodsList_Handler,
-		},
-		{
-			MethodName: "BatchGetGoods",
-			Handler:    _Goods_BatchGetGoods_Handler,
-		},
-		{
-			MethodName: "CreateGoods",
-			Handler:    _Goods_CreateGoods_Handler,
-		},
-		{
-			MethodName: "DeleteGoods",
-			Handler:    _Goods_DeleteGoods_Handler,
-		},
-		{
-			MethodName: "UpdateGoods",
-			Handler:    _Goods_UpdateGoods_Handler,
-		},
-		{
-			MethodName: "GetGoodsDetail",
-			Handler:    _Goods_GetGoodsDetail_Handler,
-		},
+func _Goods_SpecTemplateList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecTemplateFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SpecTemplateList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SpecTemplateList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SpecTemplateList(ctx, req.(*SpecTemplateFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateSpecTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecTemplateInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateSpecTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateSpecTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateSpecTemplate(ctx, req.(*SpecTemplateInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateSpecTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecTemplateInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateSpecTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateSpecTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateSpecTemplate(ctx, req.(*SpecTemplateInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteSpecTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecTemplateInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteSpecTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteSpecTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteSpecTemplate(ctx, req.(*SpecTemplateInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_SkuList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).SkuList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_SkuList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).SkuList(ctx, req.(*GoodInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_BatchGetSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSkuIdInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).BatchGetSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_BatchGetSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).BatchGetSku(ctx, req.(*BatchSkuIdInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateSku(ctx, req.(*SkuInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UpdateSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UpdateSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UpdateSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UpdateSku(ctx, req.(*SkuInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteSku_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteSku(ctx, req.(*SkuInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_UserFavList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).UserFavList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_UserFavList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).UserFavList(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_AddUserFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).AddUserFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_AddUserFav_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).AddUserFav(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_DeleteUserFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).DeleteUserFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_DeleteUserFav_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).DeleteUserFav(ctx, req.(*UserFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CheckUserFav_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserFavCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CheckUserFav(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CheckUserFav_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CheckUserFav(ctx, req.(*UserFavCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ReconcileFavNum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileFavRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ReconcileFavNum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ReconcileFavNum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ReconcileFavNum(ctx, req.(*ReconcileFavRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ReviewList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ReviewList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ReviewList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ReviewList(ctx, req.(*ReviewFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).CreateReview(ctx, req.(*ReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ReplyReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ReplyReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ReplyReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ReplyReview(ctx, req.(*ReviewReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewModerateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).ModerateReview(ctx, req.(*ReviewModerateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Goods_HelpfulReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewHelpfulRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServer).HelpfulReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Goods_HelpfulReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServer).HelpfulReview(ctx, req.(*ReviewHelpfulRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Goods_ServiceDesc is the grpc.ServiceDesc for Goods service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Goods_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Goods",
+	HandlerType: (*GoodsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GoodsList",
+			Handler:    _Goods_GoodsList_Handler,
+		},
+		{
+			MethodName: "BatchGetGoods",
+			Handler:    _Goods_BatchGetGoods_Handler,
+		},
+		{
+			MethodName: "CreateGoods",
+			Handler:    _Goods_CreateGoods_Handler,
+		},
+		{
+			MethodName: "DeleteGoods",
+			Handler:    _Goods_DeleteGoods_Handler,
+		},
+		{
+			MethodName: "UpdateGoods",
+			Handler:    _Goods_UpdateGoods_Handler,
+		},
+		{
+			MethodName: "GetGoodsDetail",
+			Handler:    _Goods_GetGoodsDetail_Handler,
+		},
+		{
+			MethodName: "ImportGoods",
+			Handler:    _Goods_ImportGoods_Handler,
+		},
 		{
 			MethodName: "GetAllCategorysList",
 			Handler:    _Goods_GetAllCategorysList_Handler,
@@ -950,6 +1710,14 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCategory",
 			Handler:    _Goods_UpdateCategory_Handler,
 		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _Goods_MoveCategory_Handler,
+		},
+		{
+			MethodName: "CategoryBreadcrumbs",
+			Handler:    _Goods_CategoryBreadcrumbs_Handler,
+		},
 		{
 			MethodName: "BrandList",
 			Handler:    _Goods_BrandList_Handler,
@@ -1002,6 +1770,82 @@ var Goods_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCategoryBrand",
 			Handler:    _Goods_UpdateCategoryBrand_Handler,
 		},
+		{
+			MethodName: "SpecTemplateList",
+			Handler:    _Goods_SpecTemplateList_Handler,
+		},
+		{
+			MethodName: "CreateSpecTemplate",
+			Handler:    _Goods_CreateSpecTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateSpecTemplate",
+			Handler:    _Goods_UpdateSpecTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteSpecTemplate",
+			Handler:    _Goods_DeleteSpecTemplate_Handler,
+		},
+		{
+			MethodName: "SkuList",
+			Handler:    _Goods_SkuList_Handler,
+		},
+		{
+			MethodName: "BatchGetSku",
+			Handler:    _Goods_BatchGetSku_Handler,
+		},
+		{
+			MethodName: "CreateSku",
+			Handler:    _Goods_CreateSku_Handler,
+		},
+		{
+			MethodName: "UpdateSku",
+			Handler:    _Goods_UpdateSku_Handler,
+		},
+		{
+			MethodName: "DeleteSku",
+			Handler:    _Goods_DeleteSku_Handler,
+		},
+		{
+			MethodName: "UserFavList",
+			Handler:    _Goods_UserFavList_Handler,
+		},
+		{
+			MethodName: "AddUserFav",
+			Handler:    _Goods_AddUserFav_Handler,
+		},
+		{
+			MethodName: "DeleteUserFav",
+			Handler:    _Goods_DeleteUserFav_Handler,
+		},
+		{
+			MethodName: "CheckUserFav",
+			Handler:    _Goods_CheckUserFav_Handler,
+		},
+		{
+			MethodName: "ReconcileFavNum",
+			Handler:    _Goods_ReconcileFavNum_Handler,
+		},
+		{
+			MethodName: "ReviewList",
+			Handler:    _Goods_ReviewList_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _Goods_CreateReview_Handler,
+		},
+		{
+			MethodName: "ReplyReview",
+			Handler:    _Goods_ReplyReview_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _Goods_ModerateReview_Handler,
+		},
+		{
+			MethodName: "HelpfulReview",
+			Handler:    _Goods_HelpfulReview_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "goods.proto",
@@ -11,8 +11,10 @@ func InitCategoryRouter(router *gin.RouterGroup) {
 	{
 		CategoryRouter.GET("", category.List)                                                            //商品分类列表
 		CategoryRouter.GET("/:id", category.Detail)                                                      //获取商品分类详情
+		CategoryRouter.GET("/:id/breadcrumbs", category.Breadcrumbs)                                     //分类的路径
 		CategoryRouter.POST("", middlewares.JWTAuth(), middlewares.IsAdminAuth(), category.New)          //添加分类
 		CategoryRouter.DELETE("/:id", middlewares.JWTAuth(), middlewares.IsAdminAuth(), category.Delete) //删除分类
 		CategoryRouter.PUT("/:id", middlewares.JWTAuth(), middlewares.IsAdminAuth(), category.Update)    //更新分类
+		CategoryRouter.PUT("/:id/move", middlewares.JWTAuth(), middlewares.IsAdminAuth(), category.Move) //移动分类
 	}
 }
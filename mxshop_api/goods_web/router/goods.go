@@ -3,18 +3,24 @@ package router
 import (
 	"github.com/gin-gonic/gin"
 	"mxshop_api/goods_web/api/goods"
+	"mxshop_api/goods_web/api/review"
+	"mxshop_api/goods_web/middlewares"
 )
 
 func InitGoodsRouter(Router *gin.RouterGroup) {
 	GoodsRouter := Router.Group("goods")
 	{
-		GoodsRouter.GET("", goods.List) //商品列表
+		GoodsRouter.GET("", goods.List)                                                             //商品列表
+		GoodsRouter.POST("/import", middlewares.JWTAuth(), middlewares.IsAdminAuth(), goods.Import) //从csv或者xlsx文件批量导入商品
+		GoodsRouter.GET("/export", middlewares.JWTAuth(), middlewares.IsAdminAuth(), goods.Export)  //按照筛选条件导出商品
 		//GoodsRouter.POST("", middlewares.JWTAuth(), middlewares.IsAdminAuth(), goods.New) //该接口需要管理员权限
 		GoodsRouter.POST("", goods.New)       // 我们测试先将这2个接口权限关闭掉
 		GoodsRouter.GET("/:id", goods.Detail) //获取商品的详情
 		//GoodsRouter.DELETE("/:id",middlewares.JWTAuth(), middlewares.IsAdminAuth(), goods.Delete) //删除商品
-		GoodsRouter.DELETE("/:id", goods.Delete)     // 我们测试先将这2个接口权限关闭掉
-		GoodsRouter.GET("/:id/stocks", goods.Stocks) //获取商品的库存
+		GoodsRouter.DELETE("/:id", goods.Delete)               // 我们测试先将这2个接口权限关闭掉
+		GoodsRouter.GET("/:id/stocks", goods.Stocks)           //获取商品的库存
+		GoodsRouter.GET("/:id/breadcrumbs", goods.Breadcrumbs) //商品所在分类的路径
+		GoodsRouter.GET("/:id/reviews", review.GoodsReviews)   //商品的评价，sort=newest|helpful
 		//GoodsRouter.PUT("/:id",middlewares.JWTAuth(), middlewares.IsAdminAuth(), goods.Update)
 		GoodsRouter.PUT("/:id", goods.Update)
 		//GoodsRouter.PATCH("/:id",middlewares.JWTAuth(), middlewares.IsAdminAuth(), goods.UpdateStatus)
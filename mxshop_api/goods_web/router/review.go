@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"mxshop_api/goods_web/api/review"
+	"mxshop_api/goods_web/middlewares"
+)
+
+// InitReviewRouter 商品评价，商品详情页的评价列表在商品的路由中
+func InitReviewRouter(Router *gin.RouterGroup) {
+	ReviewRouter := Router.Group("reviews").Use(middlewares.JWTAuth())
+	{
+		ReviewRouter.GET("", middlewares.IsAdminAuth(), review.List)               // 后台查询评价
+		ReviewRouter.POST("", review.New)                                          // 评价订单中的商品
+		ReviewRouter.GET("/mine", review.MyReviews)                                // 我的评价
+		ReviewRouter.POST("/:id/helpful", review.Helpful)                          // 标记评价有用
+		ReviewRouter.PATCH("/:id", middlewares.IsAdminAuth(), review.UpdateStatus) // 审核评价
+		ReviewRouter.PUT("/:id/reply", middlewares.IsAdminAuth(), review.Reply)    // 商家回复
+	}
+}
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"mxshop_api/goods_web/api/upload"
+	"mxshop_api/goods_web/middlewares"
+)
+
+// InitUploadRouter 商品图片、品牌logo和轮播图的上传
+func InitUploadRouter(Router *gin.RouterGroup) {
+	UploadRouter := Router.Group("upload").Use(middlewares.JWTAuth(), middlewares.IsAdminAuth())
+	{
+		UploadRouter.POST("", upload.Upload)   // 上传图片
+		UploadRouter.GET("/sign", upload.Sign) // 获取签名地址
+	}
+	Router.GET("files/*key", upload.File) // 本地存储的文件
+}
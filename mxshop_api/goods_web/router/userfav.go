@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"mxshop_api/goods_web/api/userfav"
+	"mxshop_api/goods_web/middlewares"
+)
+
+// InitUserFavRouter 用户收藏，只能操作自己的收藏
+func InitUserFavRouter(Router *gin.RouterGroup) {
+	UserFavRouter := Router.Group("userfavs").Use(middlewares.JWTAuth())
+	{
+		UserFavRouter.GET("", userfav.List)                                            // 收藏列表
+		UserFavRouter.POST("", userfav.New)                                            // 收藏商品
+		UserFavRouter.GET("/check", userfav.Check)                                     // 批量查询是否收藏
+		UserFavRouter.POST("/reconcile", middlewares.IsAdminAuth(), userfav.Reconcile) // 修正商品的收藏数
+		UserFavRouter.GET("/:id", userfav.Detail)                                      // 是否收藏了商品
+		UserFavRouter.DELETE("/:id", userfav.Delete)                                   // 取消收藏
+	}
+}
@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Local 保存在本地磁盘上的文件，由goods_web的 /files 接口对外提供访问
+type Local struct {
+	Root    string // 文件保存的目录
+	BaseURL string // 访问文件的地址前缀，例如 http://127.0.0.1:8082/g/v1/files
+	Secret  string // 签名的密钥
+	Private bool   // 私有的文件只能通过签名地址访问
+}
+
+func NewLocal(root, baseURL, secret string, private bool) (*Local, error) {
+	if secret == "" {
+		return nil, errors.New("本地存储没有配置签名密钥")
+	}
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, err
+	}
+	return &Local{Root: root, BaseURL: strings.TrimRight(baseURL, "/"), Secret: secret, Private: private}, nil
+}
+
+// Path 文件在磁盘上的路径，key中不能包含 .. 之类跳出保存目录的部分
+func (l *Local) Path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if key == "" || clean != "/"+key {
+		return "", fmt.Errorf("文件路径不合法: %s", key)
+	}
+	return filepath.Join(l.Root, filepath.FromSlash(clean)), nil
+}
+
+func (l *Local) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	p, err := l.Path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	// 先写临时文件再改名，读取的一方不会读到写了一半的文件
+	tmp, err := os.CreateTemp(filepath.Dir(p), ".upload-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), p)
+}
+
+func (l *Local) Exists(ctx context.Context, key string) (bool, error) {
+	p, err := l.Path(key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (l *Local) URL(key string) string {
+	return l.BaseURL + "/" + key
+}
+
+func (l *Local) SignedURL(key string, expire time.Duration) (string, error) {
+	expires := time.Now().Add(expire).Unix()
+	return fmt.Sprintf("%s?expires=%d&sign=%s", l.URL(key), expires, l.sign(key, expires)), nil
+}
+
+// Verify 检查签名地址中的签名和有效期
+func (l *Local) Verify(key, expires, sign string, now time.Time) bool {
+	e, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || now.Unix() > e {
+		return false
+	}
+	return hmac.Equal([]byte(sign), []byte(l.sign(key, e)))
+}
+
+func (l *Local) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, []byte(l.Secret))
+	fmt.Fprintf(mac, "%s\n%d", key, expires)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,224 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// S3 兼容S3协议的对象存储，例如aws s3、minio、阿里云oss的s3兼容接口
+// 请求使用 AWS Signature Version 4 签名
+type S3 struct {
+	Endpoint  string // 例如 https://s3.amazonaws.com 或者 http://127.0.0.1:9000
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool   // minio之类的服务使用 endpoint/bucket/key 的地址
+	BaseURL   string // 公开访问的地址前缀，例如cdn的地址，不配置时使用存储的地址
+
+	Now    func() time.Time // 签名使用的时间，测试时可以替换
+	client *http.Client
+}
+
+func NewS3(endpoint, region, bucket, accessKey, secretKey string, pathStyle bool, baseURL string) (*S3, error) {
+	if _, err := url.Parse(endpoint); err != nil || bucket == "" {
+		return nil, fmt.Errorf("对象存储配置不正确: %s %s", endpoint, bucket)
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+	return &S3{
+		Endpoint:  strings.TrimRight(endpoint, "/"),
+		Region:    region,
+		Bucket:    bucket,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+		PathStyle: pathStyle,
+		BaseURL:   strings.TrimRight(baseURL, "/"),
+		client:    &http.Client{Timeout: 30 * time.Second},
+		Now:       time.Now,
+	}, nil
+}
+
+// objectURL 对象在存储中的地址
+func (s *S3) objectURL(key string) *url.URL {
+	u, _ := url.Parse(s.Endpoint)
+	if s.PathStyle {
+		u.Path = "/" + s.Bucket + "/" + key
+	} else {
+		u.Host = s.Bucket + "." + u.Host
+		u.Path = "/" + key
+	}
+	return u
+}
+
+func (s *S3) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	s.signRequest(req, data)
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(rsp.Body, 512))
+		return fmt.Errorf("上传文件失败: %s %s", rsp.Status, body)
+	}
+	return nil
+}
+
+func (s *S3) Exists(ctx context.Context, key string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(key).String(), nil)
+	if err != nil {
+		return false, err
+	}
+	s.signRequest(req, nil)
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	rsp.Body.Close()
+	switch rsp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+	return false, fmt.Errorf("查询文件失败: %s", rsp.Status)
+}
+
+func (s *S3) URL(key string) string {
+	if s.BaseURL != "" {
+		return s.BaseURL + "/" + key
+	}
+	return s.objectURL(key).String()
+}
+
+// SignedURL 预签名的下载地址，S3最长支持7天
+func (s *S3) SignedURL(key string, expire time.Duration) (string, error) {
+	if expire <= 0 || expire > 7*24*time.Hour {
+		return "", fmt.Errorf("签名有效期不正确: %s", expire)
+	}
+	u := s.objectURL(key)
+	now := s.Now().UTC()
+	date := now.Format("20060102T150405Z")
+	scope := s.scope(now)
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", s.AccessKey+"/"+scope)
+	query.Set("X-Amz-Date", date)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expire.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	canonicalQuery := canonicalQueryString(query)
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet,
+		uriEncode(u.Path, false),
+		canonicalQuery,
+		"host:" + u.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	signature := s.signature(now, date, scope, canonicalRequest)
+	u.RawQuery = canonicalQuery + "&X-Amz-Signature=" + signature
+	return u.String(), nil
+}
+
+// signRequest 在请求头中签名
+func (s *S3) signRequest(req *http.Request, payload []byte) {
+	now := s.Now().UTC()
+	date := now.Format("20060102T150405Z")
+	scope := s.scope(now)
+	payloadHash := sha256Hex(payload)
+	req.Header.Set("X-Amz-Date", date)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + date + "\n"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQueryString(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	signature := s.signature(now, date, scope, canonicalRequest)
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.AccessKey, scope, signedHeaders, signature))
+}
+
+func (s *S3) scope(now time.Time) string {
+	return now.Format("20060102") + "/" + s.Region + "/s3/aws4_request"
+}
+
+func (s *S3) signature(now time.Time, date, scope, canonicalRequest string) string {
+	stringToSign := "AWS4-HMAC-SHA256\n" + date + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+	key := hmacSHA256([]byte("AWS4"+s.SecretKey), now.Format("20060102"))
+	key = hmacSHA256(key, s.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// canonicalQueryString 参数按照名称排序，名称和值都需要编码
+func canonicalQueryString(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode 按照签名的要求编码，除了字母数字和 -_.~ 都要编码，路径中的 / 不编码
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
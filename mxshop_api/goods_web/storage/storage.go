@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"context"
+	"time"
+)
+
+// Storage 图片文件的存储
+// key是文件在存储中的路径，例如 images/ab/ab12...ef.jpg
+type Storage interface {
+	// Put 保存文件，已经存在的文件会被覆盖
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	// Exists 文件是否已经存在，用来跳过内容相同的文件
+	Exists(ctx context.Context, key string) (bool, error)
+	// URL 文件的公开访问地址，保存到商品、品牌和轮播图中
+	URL(key string) string
+	// SignedURL 带签名的访问地址，过了有效期之后不能访问
+	SignedURL(key string, expire time.Duration) (string, error)
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"mxshop_api/goods_web/global"
+	"mxshop_api/goods_web/initialize"
+	"mxshop_api/goods_web/proto"
+)
+
+/*
+	商品接口的测试，商品服务和库存服务使用进程内的fake，不需要启动其他服务
+*/
+
+// goodsClient 只实现了商品列表、新建和删除商品，记录列表的请求和删除的商品
+type goodsClient struct {
+	proto.GoodsClient
+	nextId  int32
+	deleted []int32
+	list    *proto.GoodsFilterRequest
+}
+
+func (c *goodsClient) GoodsList(ctx context.Context, in *proto.GoodsFilterRequest, opts ...grpc.CallOption) (*proto.GoodsListResponse, error) {
+	c.list = in
+	return &proto.GoodsListResponse{}, nil
+}
+
+func (c *goodsClient) CreateGoods(ctx context.Context, in *proto.CreateGoodsInfo, opts ...grpc.CallOption) (*proto.GoodsInfoResponse, error) {
+	c.nextId++
+	return &proto.GoodsInfoResponse{Id: c.nextId, Name: in.Name}, nil
+}
+
+func (c *goodsClient) DeleteGoods(ctx context.Context, in *proto.DeleteGoodsInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.deleted = append(c.deleted, in.Id)
+	return &emptypb.Empty{}, nil
+}
+
+// inventoryClient 只实现了设置和查询库存，SetInvErr不为空时设置库存失败
+type inventoryClient struct {
+	proto.InventoryClient
+	SetInvErr error
+	stocks    map[int32]int32
+}
+
+func (c *inventoryClient) SetInv(ctx context.Context, in *proto.GoodsInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if c.SetInvErr != nil {
+		return nil, c.SetInvErr
+	}
+	c.stocks[in.GoodsId] = in.Num
+	return &emptypb.Empty{}, nil
+}
+
+func (c *inventoryClient) InvDetail(ctx context.Context, in *proto.GoodsInvInfo, opts ...grpc.CallOption) (*proto.GoodsInvInfo, error) {
+	num, ok := c.stocks[in.GoodsId]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "库存信息不存在")
+	}
+	return &proto.GoodsInvInfo{GoodsId: in.GoodsId, Num: num, Warehouses: []*proto.WarehouseStock{
+		{WarehouseId: 1, Name: "默认仓库", Num: num},
+	}}, nil
+}
+
+var (
+	goods  *goodsClient
+	inv    *inventoryClient
+	router *gin.Engine
+)
+
+func Init() {
+	gin.SetMode(gin.TestMode)
+	initialize.InitLogger()
+	if err := initialize.InitTrans("zh"); err != nil {
+		panic(err)
+	}
+	goods = &goodsClient{}
+	inv = &inventoryClient{stocks: map[int32]int32{}}
+	global.GoodsSrvClient = goods
+	global.InventorySrvClient = inv
+	router = initialize.Routers()
+}
+
+func do(method, path string, body interface{}) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func goodsForm() map[string]interface{} {
+	return map[string]interface{}{
+		"name":         "测试商品",
+		"goods_sn":     "sn001",
+		"stocks":       100,
+		"category":     1,
+		"market_price": 20,
+		"shop_price":   10,
+		"goods_brief":  "测试商品简介",
+		"images":       []string{"https://img.mxshop.com/1.jpg"},
+		"desc_images":  []string{"https://img.mxshop.com/2.jpg"},
+		"ship_free":    true,
+		"front_image":  "https://img.mxshop.com/1.jpg",
+		"brand":        1,
+	}
+}
+
+// TestNew 新建商品之后设置库存，可以查询到库存
+func TestNew() {
+	w := do(http.MethodPost, "/g/v1/goods", goodsForm())
+	if w.Code != http.StatusOK {
+		panic(fmt.Sprintf("新建商品应该成功，实际返回 %d %s", w.Code, w.Body.String()))
+	}
+	var rsp struct {
+		Id int32 `json:"id"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &rsp); err != nil {
+		panic(err)
+	}
+	if inv.stocks[rsp.Id] != 100 || len(goods.deleted) != 0 {
+		panic("新建商品之后应该设置库存")
+	}
+
+	w = do(http.MethodGet, fmt.Sprintf("/g/v1/goods/%d/stocks", rsp.Id), nil)
+	var stocks struct {
+		GoodsId    int32 `json:"goods_id"`
+		Stocks     int32 `json:"stocks"`
+		Warehouses []struct {
+			Id     int32 `json:"id"`
+			Stocks int32 `json:"stocks"`
+		} `json:"warehouses"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &stocks); err != nil || w.Code != http.StatusOK {
+		panic(fmt.Sprintf("查询库存应该成功，实际返回 %d %s", w.Code, w.Body.String()))
+	}
+	if stocks.GoodsId != rsp.Id || stocks.Stocks != 100 || len(stocks.Warehouses) != 1 || stocks.Warehouses[0].Stocks != 100 {
+		panic(fmt.Sprintf("库存不正确: %s", w.Body.String()))
+	}
+}
+
+// TestNewSetInvFailed 设置库存失败时删除刚创建的商品并返回错误
+func TestNewSetInvFailed() {
+	inv.SetInvErr = status.Errorf(codes.Unavailable, "库存服务不可用")
+	defer func() { inv.SetInvErr = nil }()
+	w := do(http.MethodPost, "/g/v1/goods", goodsForm())
+	if w.Code != http.StatusInternalServerError {
+		panic(fmt.Sprintf("设置库存失败时应该返回500，实际返回 %d", w.Code))
+	}
+	if len(goods.deleted) != 1 || goods.deleted[0] != goods.nextId {
+		panic(fmt.Sprintf("设置库存失败时应该删除刚创建的商品，实际删除 %v", goods.deleted))
+	}
+	if _, ok := inv.stocks[goods.nextId]; ok {
+		panic("设置库存失败时不应该有库存")
+	}
+}
+
+// TestStocksNotFound 没有库存信息的商品返回400，id不合法返回404
+func TestStocksNotFound() {
+	if w := do(http.MethodGet, "/g/v1/goods/9999/stocks", nil); w.Code != http.StatusBadRequest {
+		panic(fmt.Sprintf("没有库存信息应该返回400，实际返回 %d", w.Code))
+	}
+	if w := do(http.MethodGet, "/g/v1/goods/abc/stocks", nil); w.Code != http.StatusNotFound {
+		panic(fmt.Sprintf("id不合法应该返回404，实际返回 %d", w.Code))
+	}
+}
+
+// list 返回商品服务收到的请求，校验失败时返回nil
+func list(query string) (*httptest.ResponseRecorder, *proto.GoodsFilterRequest) {
+	goods.list = nil
+	w := do(http.MethodGet, "/g/v1/goods?"+query, nil)
+	return w, goods.list
+}
+
+// TestListLegacyQuery 之前版本的查询参数仍然可以使用，同时传了新参数时以新参数为准
+func TestListLegacyQuery() {
+	w, req := list("pmin=10&pmax=100&ih=1&in=0&c=3&b=0&p=2&pnum=20&f=1&pb=50,100")
+	if w.Code != http.StatusOK {
+		panic(fmt.Sprintf("旧的查询参数应该可以使用，实际返回 %d %s", w.Code, w.Body.String()))
+	}
+	if req.PriceMin != 10 || req.PriceMax != 100 || !req.IsHot || req.IsNew ||
+		len(req.Categories) != 1 || req.Categories[0] != 3 || len(req.Brands) != 0 ||
+		req.Pages != 2 || req.PagePerNums != 20 || !req.WithFacets ||
+		len(req.PriceBuckets) != 2 || req.PriceBuckets[0] != 50 || req.PriceBuckets[1] != 100 {
+		panic(fmt.Sprintf("旧的查询参数转换不正确: %v", req))
+	}
+
+	_, req = list("price_min=5&pmin=10&brand=1&brand=2&b=3")
+	if req == nil || req.PriceMin != 5 || len(req.Brands) != 2 {
+		panic(fmt.Sprintf("同时传了新参数时应该以新参数为准: %v", req))
+	}
+}
+
+// TestListValidation 查询参数不合法时返回400，不请求商品服务
+func TestListValidation() {
+	for _, query := range []string{
+		"price_min=100&price_max=10",
+		"price_min=-1",
+		"sort=hot",
+		"page_size=101",
+		"category=0",
+		"pb=10,abc",
+		"pmin=abc",
+	} {
+		w, req := list(query)
+		if w.Code != http.StatusBadRequest || req != nil {
+			panic(fmt.Sprintf("%s 应该返回400，实际返回 %d", query, w.Code))
+		}
+	}
+}
+
+// TestListSort 排序参数转换成商品服务的排序方式，不传时由商品服务决定
+func TestListSort() {
+	for sort, want := range map[string]proto.GoodsSort{
+		"":           proto.GoodsSort(0),
+		"price_asc":  proto.GoodsSort_SORT_PRICE_ASC,
+		"price_desc": proto.GoodsSort_SORT_PRICE_DESC,
+		"sold":       proto.GoodsSort_SORT_SOLD,
+		"click":      proto.GoodsSort_SORT_CLICK,
+		"newest":     proto.GoodsSort_SORT_NEWEST,
+		"relevance":  proto.GoodsSort_SORT_RELEVANCE,
+	} {
+		w, req := list("sort=" + sort)
+		if w.Code != http.StatusOK || req.Sort != want {
+			panic(fmt.Sprintf("sort=%s 应该转换成 %s，实际返回 %d %v", sort, want, w.Code, req))
+		}
+	}
+}
+
+func main() {
+	Init()
+	TestNew()
+	TestNewSetInvFailed()
+	TestStocksNotFound()
+	TestListLegacyQuery()
+	TestListValidation()
+	TestListSort()
+	fmt.Println("ok")
+}
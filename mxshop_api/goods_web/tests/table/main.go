@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"mxshop_api/goods_web/utils"
+)
+
+/*
+	商品导入导出文件读写的测试，不依赖商品服务
+*/
+
+var rows = [][]string{
+	{"goods_sn", "name", "category", "images", "goods_desc"},
+	{"sn001", "新鲜水果", "生鲜/水果/苹果", "https://img.mxshop.com/1.jpg|https://img.mxshop.com/2.jpg", `<p>"好吃",便宜</p>`},
+	{"sn002", "多行\n描述", "苹果", "", ""},
+}
+
+// TestRoundTrip 写入之后读出的内容一致
+func TestRoundTrip() {
+	for _, format := range []string{utils.TableCSV, utils.TableXLSX} {
+		var buf bytes.Buffer
+		w, err := utils.NewTableWriter(format, &buf)
+		if err != nil {
+			panic(err)
+		}
+		for _, row := range rows {
+			if err := w.Write(row); err != nil {
+				panic(err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			panic(err)
+		}
+		got, err := utils.ReadTable(format, &buf, 10)
+		if err != nil {
+			panic(fmt.Sprintf("读取%s失败: %s", format, err))
+		}
+		if !reflect.DeepEqual(trimRows(got), rows) {
+			panic(fmt.Sprintf("%s读出的内容不一致: %q", format, got))
+		}
+	}
+}
+
+// xlsx每一行末尾的空单元格不返回，补齐之后比较
+func trimRows(table [][]string) [][]string {
+	for i, row := range table {
+		for len(row) < len(rows[i]) {
+			row = append(row, "")
+		}
+		table[i] = row
+	}
+	return table
+}
+
+// TestCSV excel保存的BOM去掉，中间的空行保留，末尾的空行去掉
+func TestCSV() {
+	data := "\xEF\xBB\xBFgoods_sn,name\nsn001,苹果\n,\nsn002,梨\n\n,\n"
+	got, err := utils.ReadTable(utils.TableCSV, strings.NewReader(data), 10)
+	if err != nil {
+		panic(err)
+	}
+	want := [][]string{{"goods_sn", "name"}, {"sn001", "苹果"}, nil, {"sn002", "梨"}}
+	if !reflect.DeepEqual(got, want) {
+		panic(fmt.Sprintf("读出的内容不正确: %q", got))
+	}
+	if _, err := utils.ReadTable(utils.TableCSV, strings.NewReader(data), 2); err == nil {
+		panic("超过最大行数应该返回错误")
+	}
+	if _, err := utils.ReadTable(utils.TableCSV, strings.NewReader("a,\"b\n"), 10); err == nil {
+		panic("格式不正确的csv应该返回错误")
+	}
+	if _, err := utils.ReadTable(utils.TableXLSX, strings.NewReader(data), 10); err == nil {
+		panic("不是xlsx的文件应该返回错误")
+	}
+}
+
+func TestTableFormat() {
+	for name, want := range map[string]string{
+		"goods.csv":  utils.TableCSV,
+		"GOODS.XLSX": utils.TableXLSX,
+		"goods.xls":  "",
+		"goods":      "",
+	} {
+		if got := utils.TableFormat(name); got != want {
+			panic(fmt.Sprintf("%s 的格式应该是%q，实际是%q", name, want, got))
+		}
+	}
+}
+
+func main() {
+	TestRoundTrip()
+	TestCSV()
+	TestTableFormat()
+	fmt.Println("ok")
+}
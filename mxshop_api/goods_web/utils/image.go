@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+// imageTypes 允许上传的图片类型和保存时使用的扩展名
+var imageTypes = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+	"image/webp": "webp",
+}
+
+// ErrImageType 不支持的图片类型
+var ErrImageType = errors.New("只支持jpg、png、gif和webp格式的图片")
+
+// DetectImage 根据文件内容判断图片的类型，返回content type和扩展名
+// 不相信客户端传的文件名和content type
+func DetectImage(data []byte) (string, string, error) {
+	contentType := http.DetectContentType(data)
+	ext, ok := imageTypes[contentType]
+	if !ok {
+		return "", "", ErrImageType
+	}
+	return contentType, ext, nil
+}
+
+// DecodeImage 解码图片，检查图片的尺寸避免解码超大的图片占满内存
+func DecodeImage(data []byte, maxPixels int) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrImageType
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, errors.New("图片尺寸太大")
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrImageType
+	}
+	return img, nil
+}
+
+// Thumbnail 按照宽度等比例缩放，返回jpg格式的缩略图
+// 透明的部分填充白色
+func Thumbnail(img image.Image, width int) ([]byte, error) {
+	bounds := img.Bounds()
+	height := bounds.Dy() * width / bounds.Dx()
+	if height == 0 {
+		height = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
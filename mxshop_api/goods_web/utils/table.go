@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// 导入导出支持的文件格式
+const (
+	TableCSV  = "csv"
+	TableXLSX = "xlsx"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// TableFormat 根据文件名判断格式，不支持的格式返回空字符串
+func TableFormat(filename string) string {
+	switch {
+	case strings.HasSuffix(strings.ToLower(filename), ".csv"):
+		return TableCSV
+	case strings.HasSuffix(strings.ToLower(filename), ".xlsx"):
+		return TableXLSX
+	}
+	return ""
+}
+
+// ReadTable 读取csv或者xlsx第一个工作表的所有行，第一行是表头
+// 超过maxRows行时返回错误，末尾的空行不计算在内
+func ReadTable(format string, r io.Reader, maxRows int) ([][]string, error) {
+	var rows [][]string
+	add := func(row []string) error {
+		if isBlankRow(row) {
+			// 先记下空行，后面还有内容时再算上，行号和文件中的一致
+			rows = append(rows, nil)
+			return nil
+		}
+		rows = append(rows, row)
+		if len(rows) > maxRows+1 {
+			return fmt.Errorf("文件最多%d行", maxRows)
+		}
+		return nil
+	}
+
+	switch format {
+	case TableCSV:
+		br := bufio.NewReader(r)
+		// excel保存的csv文件开头有BOM
+		if prefix, _ := br.Peek(len(utf8BOM)); bytes.Equal(prefix, utf8BOM) {
+			br.Discard(len(utf8BOM))
+		}
+		cr := csv.NewReader(br)
+		cr.FieldsPerRecord = -1
+		for {
+			row, err := cr.Read()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("csv格式不正确: %w", err)
+			}
+			if err := add(row); err != nil {
+				return nil, err
+			}
+		}
+	case TableXLSX:
+		f, err := excelize.OpenReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("xlsx格式不正确: %w", err)
+		}
+		defer f.Close()
+		sheets := f.GetSheetList()
+		if len(sheets) == 0 {
+			return nil, errors.New("xlsx文件没有工作表")
+		}
+		iter, err := f.Rows(sheets[0])
+		if err != nil {
+			return nil, fmt.Errorf("xlsx格式不正确: %w", err)
+		}
+		defer iter.Close()
+		for iter.Next() {
+			row, err := iter.Columns()
+			if err != nil {
+				return nil, fmt.Errorf("xlsx格式不正确: %w", err)
+			}
+			if err := add(row); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("不支持的文件格式: %s", format)
+	}
+
+	for len(rows) > 0 && rows[len(rows)-1] == nil {
+		rows = rows[:len(rows)-1]
+	}
+	return rows, nil
+}
+
+func isBlankRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// TableWriter 逐行写入csv或者xlsx，导出大量数据时不需要全部放在内存中
+type TableWriter interface {
+	Write(row []string) error
+	// Flush 把已经写入的行发送出去，xlsx只能在Close时一次写出
+	Flush() error
+	Close() error
+}
+
+// NewTableWriter 创建写入w的TableWriter，Close之后内容才完整
+func NewTableWriter(format string, w io.Writer) (TableWriter, error) {
+	switch format {
+	case TableCSV:
+		// 写入BOM，excel打开时中文不会乱码
+		if _, err := w.Write(utf8BOM); err != nil {
+			return nil, err
+		}
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case TableXLSX:
+		f := excelize.NewFile()
+		sw, err := f.NewStreamWriter(f.GetSheetName(0))
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &xlsxWriter{f: f, sw: sw, out: w}, nil
+	}
+	return nil, fmt.Errorf("不支持的文件格式: %s", format)
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) Write(row []string) error {
+	return c.w.Write(row)
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) Close() error {
+	return c.Flush()
+}
+
+// xlsxWriter 使用excelize的StreamWriter，行数多时内容保存在临时文件中
+type xlsxWriter struct {
+	f    *excelize.File
+	sw   *excelize.StreamWriter
+	out  io.Writer
+	rows int
+}
+
+func (x *xlsxWriter) Write(row []string) error {
+	x.rows++
+	cells := make([]interface{}, len(row))
+	for i, value := range row {
+		cells[i] = value
+	}
+	cell, err := excelize.CoordinatesToCellName(1, x.rows)
+	if err != nil {
+		return err
+	}
+	return x.sw.SetRow(cell, cells)
+}
+
+func (x *xlsxWriter) Flush() error {
+	return nil
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.f.Close()
+	if err := x.sw.Flush(); err != nil {
+		return err
+	}
+	_, err := x.f.WriteTo(x.out)
+	return err
+}
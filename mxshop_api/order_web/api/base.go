@@ -51,6 +51,10 @@ func HandleGrpcErrToHttp(err error, c *gin.Context) {
 				c.JSON(http.StatusBadRequest, gin.H{
 					"msg": "参数错误",
 				})
+			case codes.FailedPrecondition, codes.Aborted:
+				c.JSON(http.StatusConflict, gin.H{
+					"msg": e.Message(),
+				})
 			case codes.Unavailable:
 				c.JSON(http.StatusInternalServerError, gin.H{
 					"msg": "用户服务不可用",
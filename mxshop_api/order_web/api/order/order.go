@@ -8,6 +8,7 @@ import (
 	"mxshop_api/order_web/forms"
 	"mxshop_api/order_web/global"
 	"mxshop_api/order_web/models"
+	"mxshop_api/order_web/payment"
 	"mxshop_api/order_web/proto"
 	"net/http"
 	"strconv"
@@ -41,14 +42,14 @@ func List(ctx *gin.Context) {
 		ItemMap["name"] = Item.Name
 		ItemMap["total"] = Item.Total
 		ItemMap["userId"] = Item.UserId
-		ItemMap["status"] = Item.Status
+		ItemMap["status"] = Item.State.String()
 		ItemMap["orderSn"] = Item.OrderSn
 		ItemMap["address"] = Item.Address
 		ItemMap["mobile"] = Item.Mobile
 		ItemMap["post"] = Item.Post
 		ItemMap["pay_type"] = Item.PayType
 		ItemMap["add_time"] = Item.AddTime
-		OrderList = append(OrderList, Item)
+		OrderList = append(OrderList, ItemMap)
 	}
 	ReMap := gin.H{
 		"total": Rsp.Total,
@@ -65,23 +66,43 @@ func CreatOrder(ctx *gin.Context) {
 	}
 	userId, _ := ctx.Get("userId")
 	Rsp, err := global.OrderSrvClient.CreateOrder(context.Background(), &proto.OrderRequest{
-		UserId:  int32(userId.(uint)),
-		Name:    OrderForm.Name,
-		Address: OrderForm.Address,
-		Mobile:  OrderForm.Mobile,
-		Post:    OrderForm.Post,
+		UserId:    int32(userId.(uint)),
+		AddressId: OrderForm.AddressId,
+		Name:      OrderForm.Name,
+		Address:   OrderForm.Address,
+		Mobile:    OrderForm.Mobile,
+		Post:      OrderForm.Post,
+		//客户端重复提交时带上同一个幂等key，只会创建一个订单
+		IdempotencyKey: ctx.GetHeader("Idempotency-Key"),
 	})
 	if err != nil {
 		zap.S().Info("新建订单失败")
 		api.HandleGrpcErrToHttp(err, ctx)
 		return
 	}
-	//TODO 此时的逻辑跳转至支付宝支付页面，可通过web层或是srv层返回支付宝支付URL
+	//返回支付链接，前端跳转到支付页面
+	payURL, err := global.Payment.PayURL(payOrder(Rsp))
+	if err != nil {
+		zap.S().Errorw("生成支付链接失败", "msg", err.Error())
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"msg": "生成支付链接失败",
+		})
+		return
+	}
 	ctx.JSON(http.StatusOK, gin.H{
-		"id": Rsp.Id,
+		"id":      Rsp.Id,
+		"pay_url": payURL,
 	})
 }
 
+func payOrder(orderInfo *proto.OrderInfoResponse) payment.Order {
+	return payment.Order{
+		OrderSn: orderInfo.OrderSn,
+		Subject: "慕学生鲜订单-" + orderInfo.OrderSn,
+		Amount:  orderInfo.Total,
+	}
+}
+
 // DetailOrder 获取订单详情
 func DetailOrder(ctx *gin.Context) {
 	orderId := ctx.Param("id")
@@ -110,10 +131,13 @@ func DetailOrder(ctx *gin.Context) {
 	reMap["orderSn"] = Rsp.OrderInfo.OrderSn
 	reMap["name"] = Rsp.OrderInfo.Name
 	reMap["userId"] = Rsp.OrderInfo.UserId
-	reMap["status"] = Rsp.OrderInfo.Status
+	reMap["status"] = Rsp.OrderInfo.State.String()
 	reMap["payType"] = Rsp.OrderInfo.PayType
 	reMap["post"] = Rsp.OrderInfo.Post
 	reMap["address"] = Rsp.OrderInfo.Address
+	reMap["province"] = Rsp.OrderInfo.Province
+	reMap["city"] = Rsp.OrderInfo.City
+	reMap["district"] = Rsp.OrderInfo.District
 	reMap["mobile"] = Rsp.OrderInfo.Mobile
 	reMap["total"] = Rsp.OrderInfo.Total
 	reMap["addTime"] = Rsp.OrderInfo.AddTime
@@ -127,9 +151,28 @@ func DetailOrder(ctx *gin.Context) {
 		goodsItem["nums"] = goods.Nums
 		goodsItem["price"] = goods.GoodsPrice
 		goodsItem["orderId"] = goods.OrderId
+		goodsItem["warehouseId"] = goods.WarehouseId
+		goodsItem["skuId"] = goods.SkuId
+		goodsItem["skuSpecs"] = goods.SkuSpecs
 		GoodsList = append(GoodsList, goodsItem)
 	}
 	reMap["goods"] = GoodsList
+	HistoryList := make([]interface{}, 0)
+	for _, history := range Rsp.History {
+		HistoryList = append(HistoryList, map[string]interface{}{
+			"from":    history.From.String(),
+			"to":      history.To.String(),
+			"remark":  history.Remark,
+			"addTime": history.AddTime,
+		})
+	}
+	reMap["history"] = HistoryList
+	//未支付的订单返回支付链接，用户可以继续支付
+	if Rsp.OrderInfo.State == proto.OrderState_WAIT_BUYER_PAY || Rsp.OrderInfo.State == proto.OrderState_PAYING {
+		if payURL, err := global.Payment.PayURL(payOrder(Rsp.OrderInfo)); err == nil {
+			reMap["pay_url"] = payURL
+		}
+	}
 	ctx.JSON(http.StatusOK, reMap)
 }
 func UpdateOrder(c *gin.Context) {
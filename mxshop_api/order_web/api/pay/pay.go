@@ -0,0 +1,129 @@
+package pay
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_api/order_web/global"
+	"mxshop_api/order_web/payment"
+	"mxshop_api/order_web/proto"
+)
+
+// Notify 支付平台的异步通知
+// 返回success之外的内容支付平台会继续重试通知，所以修改订单状态失败时返回fail
+func Notify(ctx *gin.Context) {
+	if err := ctx.Request.ParseForm(); err != nil {
+		ctx.String(http.StatusBadRequest, "fail")
+		return
+	}
+	if err := HandleNotify(context.Background(), ctx.Request.PostForm); err != nil {
+		ctx.String(http.StatusOK, "fail")
+		return
+	}
+	ctx.String(http.StatusOK, "success")
+}
+
+// HandleNotify 校验异步通知，支付成功之后修改订单状态
+func HandleNotify(ctx context.Context, values url.Values) error {
+	notify, err := global.Payment.VerifyNotify(values)
+	if err != nil {
+		zap.S().Errorw("[Notify] 校验支付通知失败", "msg", err.Error())
+		return err
+	}
+	if !notify.Paid() {
+		// 未支付的订单由订单服务超时关闭，这里不处理
+		return nil
+	}
+
+	// 支付的金额必须和订单的金额一致
+	detail, err := global.OrderSrvClient.OrderDetail(ctx, &proto.OrderRequest{OrderSn: notify.OrderSn})
+	if err != nil {
+		zap.S().Errorw("[Notify] 查询订单失败", "orderSn", notify.OrderSn, "msg", err.Error())
+		return err
+	}
+	if !sameAmount(notify.Amount, detail.OrderInfo.Total) {
+		zap.S().Errorw("[Notify] 支付金额和订单金额不一致", "orderSn", notify.OrderSn,
+			"amount", notify.Amount, "total", detail.OrderInfo.Total)
+		return errAmount
+	}
+
+	_, err = global.OrderSrvClient.UpdateOrderStatus(ctx, &proto.OrderStatus{
+		OrderSn: notify.OrderSn,
+		Status:  notify.TradeStatus, // 旧版本的订单服务只读取字符串的状态
+		State:   proto.OrderState(proto.OrderState_value[notify.TradeStatus]),
+		PayType: global.Payment.Name(),
+		TradeNo: notify.TradeNo,
+		PayTime: notify.PayTime.Unix(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition && alreadyPaid(ctx, notify.OrderSn) {
+			// 重复的通知，订单已经是支付成功的状态
+			return nil
+		}
+		// 其他的情况(比如订单已经超时关闭)返回fail，支付平台会继续通知，需要人工处理退款
+		zap.S().Errorw("[Notify] 修改订单状态失败", "orderSn", notify.OrderSn, "tradeNo", notify.TradeNo, "msg", err.Error())
+		return err
+	}
+	return nil
+}
+
+var errAmount = errors.New("支付金额和订单金额不一致")
+
+// sameAmount 通知中的金额是保留两位小数的字符串，按照分比较
+func sameAmount(amount string, total float32) bool {
+	paid, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return false
+	}
+	return math.Round(paid*100) == math.Round(float64(total)*100)
+}
+
+// alreadyPaid 订单是否已经是支付成功的状态
+func alreadyPaid(ctx context.Context, orderSn string) bool {
+	detail, err := global.OrderSrvClient.OrderDetail(ctx, &proto.OrderRequest{OrderSn: orderSn})
+	if err != nil {
+		return false
+	}
+	state := detail.OrderInfo.State
+	return state == proto.OrderState_TRADE_SUCCESS || state == proto.OrderState_TRADE_FINISHED
+}
+
+// FakeGateway 模拟支付的网关，打开支付链接就相当于支付成功，直接在进程内处理支付通知
+func FakeGateway(ctx *gin.Context) {
+	fake, ok := global.Payment.(*payment.Fake)
+	if !ok {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+	values, err := fake.Pay(ctx.Request.URL.Query())
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": err.Error(),
+		})
+		return
+	}
+	if err = HandleNotify(context.Background(), values); err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"msg": "支付失败",
+		})
+		return
+	}
+	if returnURL := global.ServerConfig.PayInfo.ReturnURL; returnURL != "" {
+		ctx.Redirect(http.StatusFound, returnURL)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"msg":     "支付成功",
+		"orderSn": values.Get("out_trade_no"),
+		"tradeNo": values.Get("trade_no"),
+	})
+}
@@ -23,9 +23,12 @@ func List(ctx *gin.Context) {
 		api.HandleGrpcErrToHttp(err, ctx)
 		return
 	}
-	var goodsIdS []int32
+	var goodsIdS, skuIdS []int32
 	for _, value := range ShopCartRsp.Data {
 		goodsIdS = append(goodsIdS, value.GoodsId)
+		if value.SkuId > 0 {
+			skuIdS = append(skuIdS, value.SkuId)
+		}
 	}
 	if len(goodsIdS) == 0 {
 		zap.S().Info("购物车数据为空")
@@ -42,6 +45,21 @@ func List(ctx *gin.Context) {
 		api.HandleGrpcErrToHttp(err, ctx)
 		return
 	}
+	// 有规格的商品显示SKU的价格和规格
+	skuMap := make(map[int32]*proto.SkuInfo)
+	if len(skuIdS) > 0 {
+		SkuRsp, err := global.GoodsSrvClient.BatchGetSku(context.Background(), &proto.BatchSkuIdInfo{
+			Id: skuIdS,
+		})
+		if err != nil {
+			zap.S().Info("[List] 批量查询 【商品规格】失败")
+			api.HandleGrpcErrToHttp(err, ctx)
+			return
+		}
+		for _, sku := range SkuRsp.Data {
+			skuMap[sku.Id] = sku
+		}
+	}
 	goodsList := make([]interface{}, 0)
 	ReqMap := make(map[string]interface{})
 	for _, item := range ShopCartRsp.Data {
@@ -55,6 +73,18 @@ func List(ctx *gin.Context) {
 				tmpMap["goods_images"] = goods.Images
 				tmpMap["nums"] = item.Nums
 				tmpMap["checked"] = item.Checked
+				if sku, ok := skuMap[item.SkuId]; ok {
+					specs := make([]interface{}, 0, len(sku.Specs))
+					for _, spec := range sku.Specs {
+						specs = append(specs, map[string]interface{}{
+							"name":  spec.Name,
+							"value": spec.Value,
+						})
+					}
+					tmpMap["sku_id"] = sku.Id
+					tmpMap["sku_specs"] = specs
+					tmpMap["goods_price"] = sku.ShopPrice
+				}
 				goodsList = append(goodsList, tmpMap)
 			}
 		}
@@ -72,17 +102,25 @@ func CreateCarItem(ctx *gin.Context) {
 		return
 	}
 	//查询商品是否存在
-	_, err := global.GoodsSrvClient.GetGoodsDetail(context.Background(), &proto.GoodInfoRequest{
+	GoodsRsp, err := global.GoodsSrvClient.GetGoodsDetail(context.Background(), &proto.GoodInfoRequest{
 		Id: cartItem.GoodsId,
 	})
 	if err != nil {
 		zap.S().Info("查询商品信息失败", err)
-		api.HandleValidatorErr(ctx, err)
+		api.HandleGrpcErrToHttp(err, ctx)
+		return
+	}
+	//有规格的商品查询SKU的库存
+	inventoryKey, ok := cartInventoryKey(GoodsRsp, cartItem.SkuId)
+	if !ok {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "商品规格不存在",
+		})
 		return
 	}
 	//查询库存
 	InvGoods, err := global.InventorySrvClient.InvDetail(context.Background(), &proto.GoodsInvInfo{
-		GoodsId: cartItem.GoodsId,
+		GoodsId: inventoryKey,
 	})
 	if err != nil {
 		zap.S().Info("查询库存失败", err)
@@ -100,6 +138,7 @@ func CreateCarItem(ctx *gin.Context) {
 	CartItemRsp, err := global.OrderSrvClient.CreateCartItem(context.Background(), &proto.CartItemRequest{
 		UserId:  int32(userId.(uint)),
 		GoodsId: cartItem.GoodsId,
+		SkuId:   cartItem.SkuId,
 		Nums:    cartItem.Nums,
 	})
 	if err != nil {
@@ -111,6 +150,32 @@ func CreateCarItem(ctx *gin.Context) {
 		"id": CartItemRsp.Id,
 	})
 }
+
+// cartInventoryKey 加入购物车的商品在库存服务中的id
+// 有规格的商品必须选择一个在售的SKU，没有规格的商品不能传SKU
+func cartInventoryKey(goods *proto.GoodsInfoResponse, skuId int32) (int32, bool) {
+	if len(goods.Skus) == 0 {
+		return goods.Id, skuId == 0
+	}
+	for _, sku := range goods.Skus {
+		if sku.Id == skuId && sku.OnSale {
+			return sku.InventoryKey, true
+		}
+	}
+	return 0, false
+}
+
+// cartSkuId 更新和删除购物车时通过查询参数sku_id指定商品的规格
+func cartSkuId(ctx *gin.Context) (int32, bool) {
+	skuId, err := strconv.Atoi(ctx.DefaultQuery("sku_id", "0"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"msg": "sku_id格式出错",
+		})
+		return 0, false
+	}
+	return int32(skuId), true
+}
 func UpdateCarItem(ctx *gin.Context) {
 	ShopCartUpdateForm := forms.UpdateShopCartForm{}
 	if err := ctx.ShouldBindJSON(&ShopCartUpdateForm); err != nil {
@@ -126,9 +191,14 @@ func UpdateCarItem(ctx *gin.Context) {
 		})
 		return
 	}
+	skuId, ok := cartSkuId(ctx)
+	if !ok {
+		return
+	}
 	userId, _ := ctx.Get("userId")
 	request := proto.CartItemRequest{
 		GoodsId: int32(CartItemId),
+		SkuId:   skuId,
 		UserId:  int32(userId.(uint)),
 		Nums:    ShopCartUpdateForm.Nums,
 		Checked: false,
@@ -155,9 +225,14 @@ func DeleteCarItem(ctx *gin.Context) {
 		})
 		return
 	}
+	skuId, ok := cartSkuId(ctx)
+	if !ok {
+		return
+	}
 	userId, _ := ctx.Get("userId")
 	_, err = global.OrderSrvClient.DeleteCartItem(context.Background(), &proto.CartItemRequest{
 		GoodsId: int32(CartItemId),
+		SkuId:   skuId,
 		UserId:  int32(userId.(uint)),
 	})
 	if err != nil {
@@ -23,6 +23,18 @@ type RedisConfig struct {
 	Expire int    `mapstructure:"expire" json:"expire"`
 }
 
+// PayConfig 支付配置，provider 可选 alipay(支付宝)、fake(本地模拟支付，只用于开发和测试)
+type PayConfig struct {
+	Provider     string `mapstructure:"provider" json:"provider"`
+	AppId        string `mapstructure:"app_id" json:"app_id"`
+	PrivateKey   string `mapstructure:"private_key" json:"private_key"`       // 应用私钥
+	AliPublicKey string `mapstructure:"ali_public_key" json:"ali_public_key"` // 支付宝公钥，用于校验异步通知
+	Secret       string `mapstructure:"secret" json:"secret"`                 // 模拟支付的签名密钥
+	Gateway      string `mapstructure:"gateway" json:"gateway"`
+	NotifyURL    string `mapstructure:"notify_url" json:"notify_url"`
+	ReturnURL    string `mapstructure:"return_url" json:"return_url"`
+}
+
 type ServerConfig struct {
 	Name             string       `mapstructure:"name" json:"name"`
 	Host             string       `mapstructure:"host" json:"host"`
@@ -33,6 +45,7 @@ type ServerConfig struct {
 	InventorySrvInfo SrvConfig    `mapstructure:"inventory_srv" json:"inventory_srv"`
 	JWTInfo          JWTConfig    `mapstructure:"jwt" json:"jwt"`
 	ConsulInfo       ConsulConfig `mapstructure:"consul" json:"consul"`
+	PayInfo          PayConfig    `mapstructure:"pay" json:"pay"`
 }
 
 type NacosConfig struct {
@@ -1,8 +1,10 @@
 package forms
 
+// OrderForms 使用收货地址下单时传address_id，否则需要填写收货人和地址
 type OrderForms struct {
-	Name    string `json:"name" form:"name" binding:"required"`
-	Address string `json:"address" form:"address" binding:"required"`
-	Mobile  string `json:"mobile" form:"mobile" binding:"required,mobile"`
-	Post    string `json:"post" form:"post" binding:"required"`
-}
\ No newline at end of file
+	AddressId int32  `json:"address_id" form:"address_id" binding:"omitempty,min=1"`
+	Name      string `json:"name" form:"name" binding:"required_without=AddressId"`
+	Address   string `json:"address" form:"address" binding:"required_without=AddressId"`
+	Mobile    string `json:"mobile" form:"mobile" binding:"required_without=AddressId,omitempty,mobile"`
+	Post      string `json:"post" form:"post" binding:"required_without=AddressId"`
+}
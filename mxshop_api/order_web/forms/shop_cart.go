@@ -3,6 +3,7 @@ package forms
 // ShopCartForm 购物车表单
 type ShopCartForm struct {
 	GoodsId int32 `json:"goods_id" form:"goods_id" binding:"required"`
+	SkuId   int32 `json:"sku_id" form:"sku_id"` // 有规格的商品必须选择规格
 	Nums    int32 `json:"nums" form:"nums" binding:"required,min=1"`
 }
 
@@ -3,6 +3,7 @@ package global
 import (
 	ut "github.com/go-playground/universal-translator"
 	"mxshop_api/order_web/config"
+	"mxshop_api/order_web/payment"
 	"mxshop_api/order_web/proto"
 )
 
@@ -13,4 +14,5 @@ var (
 	GoodsSrvClient     proto.GoodsClient
 	OrderSrvClient     proto.OrderClient
 	InventorySrvClient proto.InventoryClient
+	Payment            payment.Payment
 )
\ No newline at end of file
@@ -0,0 +1,17 @@
+package initialize
+
+import (
+	"go.uber.org/zap"
+
+	"mxshop_api/order_web/global"
+	"mxshop_api/order_web/payment"
+)
+
+// InitPayment 根据配置初始化支付方式
+func InitPayment() {
+	p, err := payment.New(global.ServerConfig.PayInfo)
+	if err != nil {
+		zap.S().Panic("[InitPayment] 初始化支付失败:", err.Error())
+	}
+	global.Payment = p
+}
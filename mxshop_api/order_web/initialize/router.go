@@ -21,5 +21,6 @@ func Routers() *gin.Engine {
 
 	router.InitOrderRouter(ApiGroup)
 	router.InitShopCartRouter(ApiGroup)
+	router.InitPayRouter(ApiGroup)
 	return Router
 }
\ No newline at end of file
@@ -2,6 +2,9 @@ package main
 
 import (
 	"fmt"
+	"github.com/gin-gonic/gin/binding"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
 	"github.com/nacos-group/nacos-sdk-go/inner/uuid"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -9,6 +12,7 @@ import (
 	"mxshop_api/order_web/initialize"
 	"mxshop_api/order_web/utils"
 	"mxshop_api/order_web/utils/register/consul"
+	myvalidator "mxshop_api/order_web/validator"
 	"os"
 	"os/signal"
 	"syscall"
@@ -21,17 +25,31 @@ func main() {
 	//2. 初始化配置文件
 	initialize.InitConfig()
 
-	//3. 初始化routers
+	//3. 初始化支付
+	initialize.InitPayment()
+
+	//4. 初始化routers
 	router := initialize.Routers()
 
-	//4. 初始化翻译
+	//5. 初始化翻译
 	if err := initialize.InitTrans("zh"); err != nil {
 		panic(err)
 	}
 
-	//5. 初始化srv的连接
+	//6. 初始化srv的连接
 	initialize.InitSrvConn()
 
+	// 注册验证器，下单表单的收货人手机号使用
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		_ = v.RegisterValidation("mobile", myvalidator.ValidateMobile)
+		_ = v.RegisterTranslation("mobile", global.Trans, func(ut ut.Translator) error {
+			return ut.Add("mobile", "{0} 非法的手机号码!", true)
+		}, func(ut ut.Translator, fe validator.FieldError) string {
+			t, _ := ut.T("mobile", fe.Field())
+			return t
+		})
+	}
+
 	viper.AutomaticEnv()
 	//如果是本地开发环境端口号固定，线上环境启动获取端口号
 	debug := viper.GetBool("DEV_CONFIG")
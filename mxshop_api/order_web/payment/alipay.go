@@ -0,0 +1,155 @@
+package payment
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"mxshop_api/order_web/config"
+)
+
+const alipayGateway = "https://openapi.alipay.com/gateway.do"
+
+// Alipay 支付宝电脑网站支付，使用RSA2(SHA256WithRSA)签名
+type Alipay struct {
+	cfg        config.PayConfig
+	privateKey *rsa.PrivateKey
+	publicKey  *rsa.PublicKey
+}
+
+func NewAlipay(cfg config.PayConfig) (*Alipay, error) {
+	if cfg.AppId == "" {
+		return nil, errors.New("没有配置支付宝app_id")
+	}
+	privateKey, err := parsePrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := parsePublicKey(cfg.AliPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Gateway == "" {
+		cfg.Gateway = alipayGateway
+	}
+	return &Alipay{cfg: cfg, privateKey: privateKey, publicKey: publicKey}, nil
+}
+
+func (a *Alipay) Name() string {
+	return ProviderAlipay
+}
+
+func (a *Alipay) PayURL(order Order) (string, error) {
+	bizContent, err := json.Marshal(map[string]string{
+		"out_trade_no": order.OrderSn,
+		"total_amount": formatAmount(order.Amount),
+		"subject":      order.Subject,
+		"product_code": "FAST_INSTANT_TRADE_PAY",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Set("app_id", a.cfg.AppId)
+	values.Set("method", "alipay.trade.page.pay")
+	values.Set("format", "JSON")
+	values.Set("charset", "utf-8")
+	values.Set("sign_type", "RSA2")
+	values.Set("timestamp", time.Now().In(cst).Format(timeLayout))
+	values.Set("version", "1.0")
+	values.Set("notify_url", a.cfg.NotifyURL)
+	values.Set("return_url", a.cfg.ReturnURL)
+	values.Set("biz_content", string(bizContent))
+
+	sign, err := a.sign(signContent(values))
+	if err != nil {
+		return "", err
+	}
+	values.Set("sign", sign)
+	return a.cfg.Gateway + "?" + values.Encode(), nil
+}
+
+// VerifyNotify 使用支付宝公钥校验异步通知的签名
+func (a *Alipay) VerifyNotify(values url.Values) (*Notify, error) {
+	sign, err := base64.StdEncoding.DecodeString(values.Get("sign"))
+	if err != nil || len(sign) == 0 {
+		return nil, ErrSign
+	}
+	hashed := sha256.Sum256([]byte(signContent(values)))
+	if err = rsa.VerifyPKCS1v15(a.publicKey, crypto.SHA256, hashed[:], sign); err != nil {
+		return nil, ErrSign
+	}
+	// 签名正确还需要确认通知是发给当前应用的
+	if values.Get("app_id") != a.cfg.AppId {
+		return nil, ErrAppId
+	}
+	return parseNotify(values)
+}
+
+func (a *Alipay) sign(content string) (string, error) {
+	hashed := sha256.Sum256([]byte(content))
+	sign, err := rsa.SignPKCS1v15(rand.Reader, a.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sign), nil
+}
+
+// decodeKey 支付宝后台生成的密钥没有PEM头，两种格式都支持
+func decodeKey(key string) ([]byte, error) {
+	key = strings.TrimSpace(key)
+	if strings.HasPrefix(key, "-----BEGIN") {
+		block, _ := pem.Decode([]byte(key))
+		if block == nil {
+			return nil, errors.New("密钥格式错误")
+		}
+		return block.Bytes, nil
+	}
+	return base64.StdEncoding.DecodeString(key)
+}
+
+// parsePrivateKey 应用私钥，支持PKCS1和PKCS8
+func parsePrivateKey(key string) (*rsa.PrivateKey, error) {
+	der, err := decodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if privateKey, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return privateKey, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("应用私钥不是RSA密钥")
+	}
+	return privateKey, nil
+}
+
+func parsePublicKey(key string) (*rsa.PublicKey, error) {
+	der, err := decodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("支付宝公钥不是RSA密钥")
+	}
+	return publicKey, nil
+}
@@ -0,0 +1,129 @@
+package payment
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"mxshop_api/order_web/config"
+)
+
+/*
+	支付的抽象，不同的支付平台实现同一个接口：
+		1. PayURL 生成跳转到支付平台的支付链接
+		2. VerifyNotify 校验支付平台的异步通知，通过之后由web层调用订单服务修改订单状态
+	异步通知的参数和签名方式和支付宝保持一致，本地的模拟支付也按照同样的格式通知
+*/
+
+const (
+	ProviderAlipay = "alipay"
+	ProviderFake   = "fake"
+)
+
+// 异步通知中的交易状态
+const (
+	TradeSuccess  = "TRADE_SUCCESS"
+	TradeFinished = "TRADE_FINISHED"
+)
+
+var (
+	ErrSign   = errors.New("签名校验失败")
+	ErrAppId  = errors.New("app_id不匹配")
+	ErrParams = errors.New("通知参数不完整")
+)
+
+// timeLayout 支付宝接口中的时间格式，时区是东八区
+const timeLayout = "2006-01-02 15:04:05"
+
+var cst = time.FixedZone("CST", 8*3600)
+
+// Order 生成支付链接需要的订单信息
+type Order struct {
+	OrderSn string
+	Subject string
+	Amount  float32
+}
+
+// Notify 从异步通知中解析出来的支付结果
+type Notify struct {
+	OrderSn     string
+	TradeNo     string // 支付平台的交易号
+	TradeStatus string
+	Amount      string
+	PayTime     time.Time
+}
+
+// Paid 是否已经支付成功
+func (n *Notify) Paid() bool {
+	return n.TradeStatus == TradeSuccess || n.TradeStatus == TradeFinished
+}
+
+type Payment interface {
+	// Name 支付方式，会保存到订单的pay_type
+	Name() string
+	PayURL(order Order) (string, error)
+	VerifyNotify(values url.Values) (*Notify, error)
+}
+
+// New 根据配置创建支付方式
+func New(cfg config.PayConfig) (Payment, error) {
+	switch cfg.Provider {
+	case ProviderAlipay:
+		return NewAlipay(cfg)
+	case ProviderFake:
+		return NewFake(cfg)
+	default:
+		return nil, fmt.Errorf("不支持的支付方式: %q", cfg.Provider)
+	}
+}
+
+// signContent 待签名的字符串：去掉sign、sign_type和空值，按参数名排序后用&拼接
+func signContent(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		if k == "sign" || k == "sign_type" || values.Get(k) == "" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteByte('&')
+		}
+		b.WriteString(k)
+		b.WriteByte('=')
+		b.WriteString(values.Get(k))
+	}
+	return b.String()
+}
+
+// parseNotify 解析两种支付方式共用的通知参数
+func parseNotify(values url.Values) (*Notify, error) {
+	notify := &Notify{
+		OrderSn:     values.Get("out_trade_no"),
+		TradeNo:     values.Get("trade_no"),
+		TradeStatus: values.Get("trade_status"),
+		Amount:      values.Get("total_amount"),
+	}
+	if notify.OrderSn == "" || notify.TradeNo == "" || notify.TradeStatus == "" {
+		return nil, ErrParams
+	}
+	if payTime := values.Get("gmt_payment"); payTime != "" {
+		t, err := time.ParseInLocation(timeLayout, payTime, cst)
+		if err != nil {
+			return nil, ErrParams
+		}
+		notify.PayTime = t
+	}
+	return notify, nil
+}
+
+func formatAmount(amount float32) string {
+	return fmt.Sprintf("%.2f", amount)
+}
@@ -0,0 +1,20 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"mxshop_api/order_web/api/pay"
+	"mxshop_api/order_web/global"
+	"mxshop_api/order_web/payment"
+)
+
+func InitPayRouter(router *gin.RouterGroup) {
+	PayRouter := router.Group("pay")
+	{
+		//异步通知由支付平台调用，不需要登录
+		PayRouter.POST("notify", pay.Notify)
+		if global.ServerConfig.PayInfo.Provider == payment.ProviderFake {
+			PayRouter.GET("fake/gateway", pay.FakeGateway) //模拟支付网关
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"mxshop_api/order_web/config"
+	"mxshop_api/order_web/global"
+	"mxshop_api/order_web/initialize"
+	"mxshop_api/order_web/payment"
+	"mxshop_api/order_web/proto"
+)
+
+/*
+	支付流程的测试，使用模拟支付和进程内的订单服务，不需要访问网络
+*/
+
+// orderClient 只实现了OrderDetail和UpdateOrderStatus，记录每个订单最后一次修改的状态
+// 和订单服务一样，只有待支付的订单可以修改状态
+type orderClient struct {
+	proto.OrderClient
+	orders map[string]*proto.OrderStatus
+	totals map[string]float32
+}
+
+func (c *orderClient) OrderDetail(ctx context.Context, in *proto.OrderRequest, opts ...grpc.CallOption) (*proto.OrderInfoDetailResponse, error) {
+	order, ok := c.orders[in.OrderSn]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+	return &proto.OrderInfoDetailResponse{OrderInfo: &proto.OrderInfoResponse{
+		OrderSn: order.OrderSn,
+		State:   order.State,
+		Total:   c.totals[in.OrderSn],
+	}}, nil
+}
+
+func (c *orderClient) UpdateOrderStatus(ctx context.Context, in *proto.OrderStatus, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	order, ok := c.orders[in.OrderSn]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+	if order.State != proto.OrderState_WAIT_BUYER_PAY {
+		return nil, status.Errorf(codes.FailedPrecondition, "订单状态不能从%s修改为%s", order.State, in.State)
+	}
+	c.orders[in.OrderSn] = in
+	return &emptypb.Empty{}, nil
+}
+
+var (
+	orders *orderClient
+	router *gin.Engine
+)
+
+func Init() {
+	gin.SetMode(gin.TestMode)
+	initialize.InitLogger()
+	global.ServerConfig.PayInfo = config.PayConfig{
+		Provider: payment.ProviderFake,
+		Secret:   "test-secret",
+		Gateway:  "http://127.0.0.1:8083/o/v1/pay/fake/gateway",
+	}
+	initialize.InitPayment()
+	orders = &orderClient{orders: map[string]*proto.OrderStatus{
+		"order1": {OrderSn: "order1", State: proto.OrderState_WAIT_BUYER_PAY},
+		"order2": {OrderSn: "order2", State: proto.OrderState_WAIT_BUYER_PAY},
+		"order3": {OrderSn: "order3", State: proto.OrderState_WAIT_BUYER_PAY},
+		"order4": {OrderSn: "order4", State: proto.OrderState_TRADE_CLOSED},
+	}, totals: map[string]float32{
+		"order1": 99.9,
+		"order2": 10,
+		"order3": 50,
+		"order4": 20,
+	}}
+	global.OrderSrvClient = orders
+	router = initialize.Routers()
+}
+
+func do(req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func payURL(orderSn string, amount float32) *url.URL {
+	raw, err := global.Payment.PayURL(payment.Order{OrderSn: orderSn, Subject: "测试订单", Amount: amount})
+	if err != nil {
+		panic(err)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// TestFakePay 打开支付链接之后订单变成支付成功，并且保存了支付信息
+func TestFakePay() {
+	u := payURL("order1", 99.9)
+	w := do(httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	if w.Code != http.StatusOK {
+		panic(fmt.Sprintf("模拟支付应该成功，实际返回 %d %s", w.Code, w.Body.String()))
+	}
+	order := orders.orders["order1"]
+	if order.State != proto.OrderState_TRADE_SUCCESS || order.Status != "TRADE_SUCCESS" || order.PayType != payment.ProviderFake || order.TradeNo == "" || order.PayTime == 0 {
+		panic(fmt.Sprintf("订单的支付信息不正确: %+v", order))
+	}
+	fmt.Println("模拟支付成功", order.TradeNo)
+}
+
+// TestTamperedPayURL 篡改支付金额之后签名校验失败
+func TestTamperedPayURL() {
+	u := payURL("order2", 99.9)
+	query := u.Query()
+	query.Set("total_amount", "0.01")
+	u.RawQuery = query.Encode()
+	w := do(httptest.NewRequest(http.MethodGet, u.RequestURI(), nil))
+	if w.Code != http.StatusBadRequest {
+		panic(fmt.Sprintf("篡改金额的支付链接应该被拒绝，实际返回 %d", w.Code))
+	}
+	if orders.orders["order2"].State != proto.OrderState_WAIT_BUYER_PAY {
+		panic("篡改金额的支付链接不应该修改订单状态")
+	}
+}
+
+func notify(values url.Values) string {
+	req := httptest.NewRequest(http.MethodPost, "/o/v1/pay/notify", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return do(req).Body.String()
+}
+
+// TestNotify 伪造的通知返回fail，重复的通知仍然返回success
+func TestNotify() {
+	values, err := global.Payment.(*payment.Fake).Pay(payURL("order2", 10).Query())
+	if err != nil {
+		panic(err)
+	}
+
+	forged := url.Values{}
+	for k, v := range values {
+		forged[k] = v
+	}
+	forged.Set("out_trade_no", "order1")
+	if rsp := notify(forged); rsp != "fail" {
+		panic(fmt.Sprintf("伪造的通知应该返回fail，实际返回 %s", rsp))
+	}
+
+	for i := 0; i < 2; i++ {
+		if rsp := notify(values); rsp != "success" {
+			panic(fmt.Sprintf("第%d次通知应该返回success，实际返回 %s", i+1, rsp))
+		}
+	}
+	if orders.orders["order2"].State != proto.OrderState_TRADE_SUCCESS {
+		panic("通知之后订单应该支付成功")
+	}
+}
+
+// TestNotifyAmount 支付金额和订单金额不一致时返回fail，不修改订单状态
+func TestNotifyAmount() {
+	values, err := global.Payment.(*payment.Fake).Pay(payURL("order3", 0.01).Query())
+	if err != nil {
+		panic(err)
+	}
+	if rsp := notify(values); rsp != "fail" {
+		panic(fmt.Sprintf("金额不一致的通知应该返回fail，实际返回 %s", rsp))
+	}
+	if orders.orders["order3"].State != proto.OrderState_WAIT_BUYER_PAY {
+		panic("金额不一致的通知不应该修改订单状态")
+	}
+
+	values, err = global.Payment.(*payment.Fake).Pay(payURL("order3", 50).Query())
+	if err != nil {
+		panic(err)
+	}
+	if rsp := notify(values); rsp != "success" {
+		panic(fmt.Sprintf("金额一致的通知应该返回success，实际返回 %s", rsp))
+	}
+}
+
+// TestNotifyClosed 已经关闭的订单收到支付通知时返回fail，支付平台会继续通知
+func TestNotifyClosed() {
+	values, err := global.Payment.(*payment.Fake).Pay(payURL("order4", 20).Query())
+	if err != nil {
+		panic(err)
+	}
+	if rsp := notify(values); rsp != "fail" {
+		panic(fmt.Sprintf("关闭的订单收到通知应该返回fail，实际返回 %s", rsp))
+	}
+	if orders.orders["order4"].State != proto.OrderState_TRADE_CLOSED {
+		panic("关闭的订单不应该修改状态")
+	}
+}
+
+// TestAlipayVerify 按照支付宝的规则签名的通知可以通过校验
+func TestAlipayVerify() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	publicKey, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	alipay, err := payment.NewAlipay(config.PayConfig{
+		AppId:        "2021000000000000",
+		PrivateKey:   base64.StdEncoding.EncodeToString(x509.MarshalPKCS1PrivateKey(key)),
+		AliPublicKey: base64.StdEncoding.EncodeToString(publicKey),
+	})
+	if err != nil {
+		panic(err)
+	}
+	if _, err = alipay.PayURL(payment.Order{OrderSn: "order1", Subject: "测试订单", Amount: 1}); err != nil {
+		panic(err)
+	}
+
+	values := url.Values{
+		"app_id":       {"2021000000000000"},
+		"out_trade_no": {"order1"},
+		"trade_no":     {"2024010122001400000000000001"},
+		"trade_status": {"TRADE_SUCCESS"},
+		"total_amount": {"1.00"},
+		"gmt_payment":  {"2024-01-01 12:00:00"},
+		"sign_type":    {"RSA2"},
+	}
+	var keys []string
+	for k := range values {
+		if k != "sign_type" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+values.Get(k))
+	}
+	hashed := sha256.Sum256([]byte(strings.Join(pairs, "&")))
+	sign, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	values.Set("sign", base64.StdEncoding.EncodeToString(sign))
+
+	n, err := alipay.VerifyNotify(values)
+	if err != nil {
+		panic(err)
+	}
+	if n.OrderSn != "order1" || !n.Paid() || n.PayTime.Unix() != 1704081600 {
+		panic(fmt.Sprintf("通知解析不正确: %+v", n))
+	}
+
+	values.Set("total_amount", "100.00")
+	if _, err = alipay.VerifyNotify(values); err != payment.ErrSign {
+		panic("篡改之后的通知应该校验失败")
+	}
+}
+
+func main() {
+	Init()
+	TestFakePay()
+	TestTamperedPayURL()
+	TestNotify()
+	TestNotifyAmount()
+	TestNotifyClosed()
+	TestAlipayVerify()
+	fmt.Println("支付测试全部通过")
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_api/user_web/forms"
+	"mxshop_api/user_web/global"
+	"mxshop_api/user_web/proto"
+)
+
+func addressToMap(address *proto.AddressResponse) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            address.Id,
+		"province":      address.Province,
+		"city":          address.City,
+		"district":      address.District,
+		"address":       address.Address,
+		"signer_name":   address.SignerName,
+		"signer_mobile": address.SignerMobile,
+		"post":          address.Post,
+		"is_default":    address.IsDefault,
+	}
+}
+
+// currentUserId 登录用户的id，地址接口只能操作自己的地址
+func currentUserId(ctx *gin.Context) int32 {
+	userId, _ := ctx.Get("userId")
+	return int32(userId.(uint))
+}
+
+// addressRequest 表单转换成AddressRequest，id为0表示新建
+func addressRequest(ctx *gin.Context, id int32) (*proto.AddressRequest, bool) {
+	addressForm := forms.AddressForm{}
+	if err := ctx.ShouldBindJSON(&addressForm); err != nil {
+		HandleValidatorError(ctx, err)
+		return nil, false
+	}
+	return &proto.AddressRequest{
+		Id:           id,
+		UserId:       currentUserId(ctx),
+		Province:     addressForm.Province,
+		City:         addressForm.City,
+		District:     addressForm.District,
+		Address:      addressForm.Address,
+		SignerName:   addressForm.SignerName,
+		SignerMobile: addressForm.SignerMobile,
+		Post:         addressForm.Post,
+		IsDefault:    addressForm.IsDefault,
+	}, true
+}
+
+func addressId(ctx *gin.Context) (int32, bool) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+		return 0, false
+	}
+	return int32(id), true
+}
+
+// AddressList 收货地址列表，默认地址在最前面
+func AddressList(ctx *gin.Context) {
+	rsp, err := global.AddressSrvClient.AddressList(context.Background(), &proto.AddressRequest{
+		UserId: currentUserId(ctx),
+	})
+	if err != nil {
+		zap.S().Errorw("[AddressList] 查询 【收货地址】失败", "msg", err.Error())
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	data := make([]interface{}, 0, len(rsp.Data))
+	for _, address := range rsp.Data {
+		data = append(data, addressToMap(address))
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"total": rsp.Total,
+		"data":  data,
+	})
+}
+
+func NewAddress(ctx *gin.Context) {
+	req, ok := addressRequest(ctx, 0)
+	if !ok {
+		return
+	}
+	rsp, err := global.AddressSrvClient.CreateAddress(context.Background(), req)
+	if err != nil {
+		if status.Code(err) == codes.ResourceExhausted {
+			// 地址数量超过上限
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"msg": status.Convert(err).Message(),
+			})
+			return
+		}
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, addressToMap(rsp))
+}
+
+func UpdateAddress(ctx *gin.Context) {
+	id, ok := addressId(ctx)
+	if !ok {
+		return
+	}
+	req, ok := addressRequest(ctx, id)
+	if !ok {
+		return
+	}
+	if _, err := global.AddressSrvClient.UpdateAddress(context.Background(), req); err != nil {
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"msg": "修改成功",
+	})
+}
+
+func DeleteAddress(ctx *gin.Context) {
+	id, ok := addressId(ctx)
+	if !ok {
+		return
+	}
+	if _, err := global.AddressSrvClient.DeleteAddress(context.Background(), &proto.AddressRequest{
+		Id:     id,
+		UserId: currentUserId(ctx),
+	}); err != nil {
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.Status(http.StatusOK)
+}
+
+// DefaultAddress 设置默认地址，下单时前端默认选中
+func DefaultAddress(ctx *gin.Context) {
+	id, ok := addressId(ctx)
+	if !ok {
+		return
+	}
+	if _, err := global.AddressSrvClient.SetDefaultAddress(context.Background(), &proto.AddressRequest{
+		Id:     id,
+		UserId: currentUserId(ctx),
+	}); err != nil {
+		HandleGrpcErrorToHttp(err, ctx)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{
+		"msg": "设置成功",
+	})
+}
@@ -2,32 +2,40 @@ package api
 
 import (
 	"context"
+	cryptorand "crypto/rand"
 	"fmt"
 	"github.com/gin-gonic/gin"
-	"math/rand"
+	"math/big"
 	"mxshop_api/user_web/forms"
 	"mxshop_api/user_web/global"
+	"mxshop_api/user_web/sms"
 	"net/http"
 	"strings"
 	"time"
 
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
-	"github.com/aliyun/alibaba-cloud-sdk-go/services/dysmsapi"
 	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
 )
 
-func GenerateSmsCode(witdh int) string {
-	//生成width长度的短信验证码
-
-	numeric := [10]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
-	r := len(numeric)
-	rand.Seed(time.Now().UnixNano())
+// smsPurposes 发送验证码表单中的type对应的验证码用途
+var smsPurposes = map[uint]string{
+	1: sms.PurposeRegister,
+	2: sms.PurposeLogin,
+	3: sms.PurposeReset,
+}
 
+// GenerateSmsCode 生成width长度的短信验证码
+// 验证码可以直接用来登录，必须使用crypto/rand生成，不能被预测
+func GenerateSmsCode(width int) (string, error) {
 	var sb strings.Builder
-	for i := 0; i < witdh; i++ {
-		fmt.Fprintf(&sb, "%d", numeric[rand.Intn(r)])
+	for i := 0; i < width; i++ {
+		n, err := cryptorand.Int(cryptorand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(n.String())
 	}
-	return sb.String()
+	return sb.String(), nil
 }
 
 func SendSms(ctx *gin.Context) {
@@ -36,35 +44,184 @@ func SendSms(ctx *gin.Context) {
 		HandleValidatorError(ctx, err)
 		return
 	}
+	purpose := smsPurposes[sendSmsForm.Type]
 
-	client, err := dysmsapi.NewClientWithAccessKey("cn-beijing", global.ServerConfig.AliSmsInfo.ApiKey, global.ServerConfig.AliSmsInfo.ApiSecret)
-	if err != nil {
-		panic(err)
+	// 1. 发送的频率限制
+	if !handleSmsLimit(ctx, sendSmsForm.Mobile, ctx.ClientIP()) {
+		return
 	}
-	smsCode := GenerateSmsCode(6)
-	request := requests.NewCommonRequest()
-	request.Method = "POST"
-	request.Scheme = "https" // https | http
-	request.Domain = "dysmsapi.aliyuncs.com"
-	request.Version = "2017-05-25"
-	request.ApiName = "SendSms"
-	request.QueryParams["RegionId"] = "cn-beijing"
-	request.QueryParams["PhoneNumbers"] = sendSmsForm.Mobile            //手机号
-	request.QueryParams["SignName"] = "慕学在线"                            //阿里云验证过的项目名 自己设置
-	request.QueryParams["TemplateCode"] = "SMS_181850725"               //阿里云的短信模板号 自己设置
-	request.QueryParams["TemplateParam"] = "{\"code\":" + smsCode + "}" //短信模板中的验证码内容 自己生成   之前试过直接返回，但是失败，加上code成功。
-	response, err := client.ProcessCommonRequest(request)
-	fmt.Print(client.DoAction(request, response))
+
+	// 2. 发送短信，失败时不保存验证码
+	smsCode, err := GenerateSmsCode(6)
 	if err != nil {
-		fmt.Print(err.Error())
+		zap.S().Errorf("生成短信验证码失败: %v", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"msg": "系统错误"})
+		return
+	}
+	if err := global.SmsSender.Send(context.Background(), sendSmsForm.Mobile, smsCode, purpose); err != nil {
+		zap.S().Errorw("[SendSms] 发送 【短信验证码】失败", "mobile", sendSmsForm.Mobile, "msg", err.Error())
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"msg": "短信发送失败",
+		})
+		return
+	}
+
+	// 3. 将验证码保存起来 - redis，新的验证码重新计算输错的次数
+	expire := global.ServerConfig.RedisInfo.Expire
+	if expire <= 0 {
+		expire = 300
 	}
-	//将验证码保存起来 - redis
-	rdb := redis.NewClient(&redis.Options{
-		Addr: fmt.Sprintf("%s:%d", global.ServerConfig.RedisInfo.Host, global.ServerConfig.RedisInfo.Port),
+	_, err = global.RedisClient.TxPipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.Set(context.Background(), smsCodeKey(purpose, sendSmsForm.Mobile), smsCode, time.Duration(expire)*time.Second)
+		pipe.Del(context.Background(), smsAttemptsKey(purpose, sendSmsForm.Mobile))
+		return nil
 	})
-	rdb.Set(context.Background(), sendSmsForm.Mobile, smsCode, time.Duration(global.ServerConfig.RedisInfo.Expire)*time.Second)
+	if err != nil {
+		zap.S().Errorf("保存短信验证码失败: %v", err)
+		ctx.JSON(http.StatusInternalServerError, gin.H{"msg": "系统错误"})
+		return
+	}
 
 	ctx.JSON(http.StatusOK, gin.H{
 		"msg": "发送成功",
 	})
-}
\ No newline at end of file
+}
+
+// smsLimitScript 所有的次数都没有超过限制时才增加次数，被拒绝的请求不占用次数
+// KEYS 需要检查的计数 ARGV[1..n] 每个计数的上限 ARGV[n+1..2n] 每个计数的有效期，单位秒
+// 返回0表示没有超过限制，否则返回超过限制的计数的序号
+var smsLimitScript = redis.NewScript(`
+local n = #KEYS
+for i = 1, n do
+	local count = tonumber(redis.call('GET', KEYS[i]) or '0')
+	if count >= tonumber(ARGV[i]) then
+		return i
+	end
+end
+for i = 1, n do
+	if redis.call('INCR', KEYS[i]) == 1 then
+		redis.call('EXPIRE', KEYS[i], ARGV[n + i])
+	end
+end
+return 0
+`)
+
+type smsLimit struct {
+	key    string
+	max    int
+	window int
+	msg    string
+}
+
+// handleSmsLimit 按照手机号和ip限制发送的频率，超过限制时返回错误，返回true表示可以发送
+// 手机号和ip的计数分两次检查，每次检查的key使用相同的hash tag，redis集群中同一个脚本的key在同一个slot
+// 先检查ip，ip超过限制时不会占用手机号的次数
+func handleSmsLimit(c *gin.Context, mobile, ip string) bool {
+	cfg := global.ServerConfig.SmsInfo
+	groups := [][]smsLimit{
+		{
+			{"sms_limit:ip:minute:{" + ip + "}", cfg.IpMinute, 60, "发送太频繁，请稍后再试"},
+			{"sms_limit:ip:day:{" + ip + "}", cfg.IpDay, 86400, "今天发送的次数太多，请明天再试"},
+		},
+		{
+			{"sms_limit:mobile:minute:{" + mobile + "}", cfg.MobileMinute, 60, "发送太频繁，请稍后再试"},
+			{"sms_limit:mobile:day:{" + mobile + "}", cfg.MobileDay, 86400, "这个手机号今天发送的次数太多，请明天再试"},
+		},
+	}
+	for _, limits := range groups {
+		exceeded, err := checkSmsLimit(limits)
+		if err != nil {
+			zap.S().Errorf("检查短信发送次数失败: %v", err)
+			c.JSON(http.StatusInternalServerError, gin.H{"msg": "系统错误"})
+			return false
+		}
+		if exceeded > 0 {
+			c.JSON(http.StatusTooManyRequests, gin.H{"msg": limits[exceeded-1].msg})
+			return false
+		}
+	}
+	return true
+}
+
+// checkSmsLimit 返回0表示没有超过限制并且增加了次数，否则返回超过限制的计数的序号
+func checkSmsLimit(limits []smsLimit) (int, error) {
+	keys := make([]string, 0, len(limits))
+	args := make([]interface{}, len(limits)*2)
+	for i, limit := range limits {
+		keys = append(keys, limit.key)
+		args[i] = limit.max
+		args[len(limits)+i] = limit.window
+	}
+	return smsLimitScript.Run(context.Background(), global.RedisClient, keys, args...).Int()
+}
+
+// 验证码和输错的次数使用相同的hash tag，redis集群中lua脚本操作的key在同一个slot
+// 不同用途的验证码分开保存，注册的验证码不能用来登录或者找回密码
+func smsCodeKey(purpose, mobile string) string {
+	return fmt.Sprintf("sms:%s:{%s}", purpose, mobile)
+}
+
+func smsAttemptsKey(purpose, mobile string) string {
+	return fmt.Sprintf("sms_attempts:%s:{%s}", purpose, mobile)
+}
+
+// 验证短信验证码的结果
+const (
+	smsCodeWrong     = 0  // 验证码错误或者已经过期
+	smsCodeOK        = 1  // 验证通过，验证码已经删除
+	smsCodeExhausted = -1 // 输错的次数太多，验证码已经删除
+)
+
+// verifySmsCodeScript 比较和删除验证码在一个脚本中完成，同一个验证码并发请求时只有一个能通过
+// KEYS[1] 验证码 KEYS[2] 输错的次数 ARGV[1] 用户输入的验证码 ARGV[2] 最多可以输错的次数
+var verifySmsCodeScript = redis.NewScript(`
+local code = redis.call('GET', KEYS[1])
+if not code then
+	return 0
+end
+if code == ARGV[1] then
+	redis.call('DEL', KEYS[1], KEYS[2])
+	return 1
+end
+local attempts = redis.call('INCR', KEYS[2])
+if attempts == 1 then
+	local ttl = redis.call('PTTL', KEYS[1])
+	if ttl > 0 then
+		redis.call('PEXPIRE', KEYS[2], ttl)
+	end
+end
+if attempts >= tonumber(ARGV[2]) then
+	redis.call('DEL', KEYS[1], KEYS[2])
+	return -1
+end
+return 0
+`)
+
+// verifySmsCode 检查短信验证码，验证通过之后验证码失效，只能使用一次
+func verifySmsCode(purpose, mobile, code string) (int64, error) {
+	maxAttempts := global.ServerConfig.SmsMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 5
+	}
+	return verifySmsCodeScript.Run(context.Background(), global.RedisClient,
+		[]string{smsCodeKey(purpose, mobile), smsAttemptsKey(purpose, mobile)}, code, maxAttempts).Int64()
+}
+
+// handleSmsCode 验证码没有通过时返回错误，返回true表示验证通过
+func handleSmsCode(c *gin.Context, purpose, mobile, code string) bool {
+	result, err := verifySmsCode(purpose, mobile, code)
+	if err != nil {
+		zap.S().Errorf("验证短信验证码失败: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{"msg": "系统错误"})
+		return false
+	}
+	switch result {
+	case smsCodeOK:
+		return true
+	case smsCodeExhausted:
+		c.JSON(http.StatusBadRequest, gin.H{"code": "验证码错误次数太多，请重新获取"})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"code": "验证码错误"})
+	}
+	return false
+}
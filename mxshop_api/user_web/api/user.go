@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	cryptorand "crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"mxshop_api/user_web/forms"
 	"mxshop_api/user_web/global"
@@ -9,6 +11,7 @@ import (
 	"mxshop_api/user_web/middlewares"
 	"mxshop_api/user_web/models"
 	"mxshop_api/user_web/proto"
+	"mxshop_api/user_web/sms"
 	"net/http"
 	"strconv"
 	"strings"
@@ -148,6 +151,11 @@ func PassWordLogin(c *gin.Context) {
 	}
 
 	// 3. 生成双Token
+	loginSuccess(c, userRsp)
+}
+
+// loginSuccess 生成双Token，RefreshToken保存到Redis，返回登录成功的响应
+func loginSuccess(c *gin.Context, userRsp *proto.UserInfoResponse) {
 	j := middlewares.NewJWT()
 	accessClaims := models.AccessClaims{
 		ID:          uint(userRsp.Id),
@@ -166,7 +174,7 @@ func PassWordLogin(c *gin.Context) {
 		return
 	}
 
-	// 4. 存储RefreshToken到Redis
+	// 存储RefreshToken到Redis
 	refreshKey := fmt.Sprintf("refresh:%d", userRsp.Id)
 	err = global.RedisClient.Set(context.Background(), refreshKey, tokenPair.RefreshToken, global.ServerConfig.JWTInfo.RefreshExpire).Err()
 	if err != nil {
@@ -175,7 +183,6 @@ func PassWordLogin(c *gin.Context) {
 		return
 	}
 
-	// 5. 返回响应
 	c.JSON(http.StatusOK, gin.H{
 		"id":            userRsp.Id,
 		"nick_name":     userRsp.NickName,
@@ -185,6 +192,53 @@ func PassWordLogin(c *gin.Context) {
 	})
 }
 
+// SmsLogin 短信验证码登录，手机号没有注册时自动注册，注册的用户使用随机密码，之后可以通过找回密码设置
+func SmsLogin(c *gin.Context) {
+	smsLoginForm := forms.SmsLoginForm{}
+	if err := c.ShouldBind(&smsLoginForm); err != nil {
+		HandleValidatorError(c, err)
+		return
+	}
+
+	// 1. 验证码，验证通过之后不能再使用
+	if !handleSmsCode(c, sms.PurposeLogin, smsLoginForm.Mobile, smsLoginForm.Code) {
+		return
+	}
+
+	// 2. 查询用户，不存在时注册
+	userRsp, err := global.UserSrvClient.GetUserByMobile(context.Background(), &proto.MobileRequest{
+		Mobile: smsLoginForm.Mobile,
+	})
+	if status.Code(err) == codes.NotFound {
+		userRsp, err = global.UserSrvClient.CreateUser(context.Background(), &proto.CreateUserInfo{
+			NickName: smsLoginForm.Mobile,
+			PassWord: randomPassword(),
+			Mobile:   smsLoginForm.Mobile,
+		})
+		if status.Code(err) == codes.AlreadyExists {
+			// 同时注册了同一个手机号，使用已经注册的用户
+			userRsp, err = global.UserSrvClient.GetUserByMobile(context.Background(), &proto.MobileRequest{
+				Mobile: smsLoginForm.Mobile,
+			})
+		}
+	}
+	if err != nil {
+		zap.S().Errorf("[SmsLogin] 查询 【用户】失败: %s", err.Error())
+		HandleGrpcErrorToHttp(err, c)
+		return
+	}
+
+	// 3. 生成双Token
+	loginSuccess(c, userRsp)
+}
+
+// randomPassword 短信登录自动注册的用户的密码，用户不知道这个密码
+func randomPassword() string {
+	b := make([]byte, 16)
+	_, _ = cryptorand.Read(b)
+	return hex.EncodeToString(b)
+}
+
 func RefreshToken(c *gin.Context) {
 	type refreshRequest struct {
 		RefreshToken string `json:"refresh_token" binding:"required"`
@@ -270,20 +324,9 @@ func Register(c *gin.Context) {
 		return
 	}
 
-	//验证码
-	value, err := global.RedisClient.Get(context.Background(), registerForm.Mobile).Result()
-	if err == redis.Nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"code": "验证码错误",
-		})
+	//验证码，验证通过之后不能再使用
+	if !handleSmsCode(c, sms.PurposeRegister, registerForm.Mobile, registerForm.Code) {
 		return
-	} else {
-		if value != registerForm.Code {
-			c.JSON(http.StatusBadRequest, gin.H{
-				"code": "验证码错误",
-			})
-			return
-		}
 	}
 
 	user, err := global.UserSrvClient.CreateUser(context.Background(), &proto.CreateUserInfo{
@@ -333,4 +376,47 @@ func Register(c *gin.Context) {
 		"refresh_token": tokenPair.RefreshToken,
 		"expires_in":    tokenPair.ExpiresIn,
 	})
-}
\ No newline at end of file
+}
+
+// ResetPassword 通过短信验证码找回密码，修改之后之前的RefreshToken失效
+func ResetPassword(c *gin.Context) {
+	resetForm := forms.ResetPasswordForm{}
+	if err := c.ShouldBind(&resetForm); err != nil {
+		HandleValidatorError(c, err)
+		return
+	}
+
+	// 1. 验证码，只能使用找回密码的验证码
+	if !handleSmsCode(c, sms.PurposeReset, resetForm.Mobile, resetForm.Code) {
+		return
+	}
+
+	// 2. 修改密码
+	userRsp, err := global.UserSrvClient.GetUserByMobile(context.Background(), &proto.MobileRequest{
+		Mobile: resetForm.Mobile,
+	})
+	if err == nil {
+		_, err = global.UserSrvClient.UpdatePassWord(context.Background(), &proto.PasswordUpdateInfo{
+			Mobile:   resetForm.Mobile,
+			PassWord: resetForm.PassWord,
+		})
+	}
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			c.JSON(http.StatusBadRequest, gin.H{"mobile": "用户不存在"})
+			return
+		}
+		zap.S().Errorf("[ResetPassword] 修改 【密码】失败: %s", err.Error())
+		HandleGrpcErrorToHttp(err, c)
+		return
+	}
+
+	// 3. 已经登录的设备需要重新登录
+	if err := global.RedisClient.Del(context.Background(), fmt.Sprintf("refresh:%d", userRsp.Id)).Err(); err != nil {
+		zap.S().Errorf("删除RefreshToken失败: %v", err)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"msg": "修改成功",
+	})
+}
@@ -16,9 +16,21 @@ type JWTConfig struct {
 	RefreshExpire time.Duration `mapstructure:"refresh_expire" json:"refresh_expire"`
 }
 
-type AliSmsConfig struct {
-	ApiKey    string `mapstructure:"key" json:"key"`
-	ApiSecret string `mapstructure:"secrect" json:"secrect"`
+// SmsConfig 短信验证码，type为aliyun或者local，不配置时有key使用aliyun否则使用local，local不发送短信，把验证码写到文件或者日志中
+type SmsConfig struct {
+	Type      string            `mapstructure:"type" json:"type"`
+	ApiKey    string            `mapstructure:"key" json:"key"`
+	ApiSecret string            `mapstructure:"secrect" json:"secrect"`
+	Region    string            `mapstructure:"region" json:"region"`       // 默认cn-beijing
+	SignName  string            `mapstructure:"sign_name" json:"sign_name"` // 阿里云验证过的签名
+	Templates map[string]string `mapstructure:"templates" json:"templates"` // 验证码用途(register、login、reset)对应的模板号
+	File      string            `mapstructure:"file" json:"file"`           // local写入的文件，为空时写到日志中
+
+	// 发送的频率限制，按照手机号和ip分别计算，不配置时使用默认值
+	MobileMinute int `mapstructure:"mobile_minute" json:"mobile_minute"` // 每个手机号每分钟，默认1
+	MobileDay    int `mapstructure:"mobile_day" json:"mobile_day"`       // 每个手机号每天，默认10
+	IpMinute     int `mapstructure:"ip_minute" json:"ip_minute"`         // 每个ip每分钟，默认5
+	IpDay        int `mapstructure:"ip_day" json:"ip_day"`               // 每个ip每天，默认50
 }
 
 type ConsulConfig struct {
@@ -39,9 +51,13 @@ type ServerConfig struct {
 	Port        int           `mapstructure:"port" json:"port"`
 	UserSrvInfo UserSrvConfig `mapstructure:"user_srv" json:"user_srv"`
 	JWTInfo     JWTConfig     `mapstructure:"jwt" json:"jwt"`
-	AliSmsInfo  AliSmsConfig  `mapstructure:"sms" json:"sms"`
+	SmsInfo     SmsConfig     `mapstructure:"sms" json:"sms"`
 	RedisInfo   RedisConfig   `mapstructure:"redis" json:"redis"`
 	ConsulInfo  ConsulConfig  `mapstructure:"consul" json:"consul"`
+	//短信验证码最多可以输错的次数，超过之后验证码失效，不配置默认5次
+	SmsMaxAttempts int `mapstructure:"sms_max_attempts" json:"sms_max_attempts"`
+	//前面的代理(比如nginx)的ip或者网段，只信任这些代理设置的X-Forwarded-For，不配置时直接使用连接的ip
+	TrustedProxies []string `mapstructure:"trusted_proxies" json:"trusted_proxies"`
 }
 
 type NacosConfig struct {
@@ -0,0 +1,13 @@
+package forms
+
+// AddressForm 收货地址，省市区分开保存
+type AddressForm struct {
+	Province     string `form:"province" json:"province" binding:"required,max=20"`
+	City         string `form:"city" json:"city" binding:"required,max=20"`
+	District     string `form:"district" json:"district" binding:"required,max=20"`
+	Address      string `form:"address" json:"address" binding:"required,max=100"`
+	SignerName   string `form:"signer_name" json:"signer_name" binding:"required,max=20"`
+	SignerMobile string `form:"signer_mobile" json:"signer_mobile" binding:"required,mobile"`
+	Post         string `form:"post" json:"post" binding:"max=20"`
+	IsDefault    bool   `form:"is_default" json:"is_default"`
+}
@@ -1,6 +1,6 @@
 package forms
 
 type SendSmsForm struct {
-	Mobile string `form:"mobile" json:"mobile" binding:"required,mobile"` // 手机号码格式有规范可寻， 自定义validator
-	Type   uint   `form:"type" json:"type" binding:"required,oneof=1 2"`  // 注册发送短信验证码和动态验证码登录发送验证码
-}
\ No newline at end of file
+	Mobile string `form:"mobile" json:"mobile" binding:"required,mobile"`  // 手机号码格式有规范可寻， 自定义validator
+	Type   uint   `form:"type" json:"type" binding:"required,oneof=1 2 3"` // 1(注册) 2(动态验证码登录) 3(找回密码)
+}
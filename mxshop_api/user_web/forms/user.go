@@ -11,4 +11,17 @@ type RegisterForm struct {
 	Mobile   string `form:"mobile" json:"mobile" binding:"required,mobile"` //手机号码格式有规范可寻， 自定义validator
 	PassWord string `form:"password" json:"password" binding:"required,min=3,max=20"`
 	Code     string `form:"code" json:"code" binding:"required,min=6,max=6"`
-}
\ No newline at end of file
+}
+
+// SmsLoginForm 短信验证码登录，手机号没有注册时自动注册
+type SmsLoginForm struct {
+	Mobile string `form:"mobile" json:"mobile" binding:"required,mobile"`
+	Code   string `form:"code" json:"code" binding:"required,min=6,max=6"`
+}
+
+// ResetPasswordForm 通过短信验证码找回密码
+type ResetPasswordForm struct {
+	Mobile   string `form:"mobile" json:"mobile" binding:"required,mobile"`
+	Code     string `form:"code" json:"code" binding:"required,min=6,max=6"`
+	PassWord string `form:"password" json:"password" binding:"required,min=3,max=20"`
+}
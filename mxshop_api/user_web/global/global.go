@@ -5,12 +5,15 @@ import (
 	"github.com/go-redis/redis/v8"
 	"mxshop_api/user_web/config"
 	"mxshop_api/user_web/proto"
+	"mxshop_api/user_web/sms"
 )
 
 var (
-	Trans         ut.Translator
-	ServerConfig  *config.ServerConfig = &config.ServerConfig{}
-	NacosConfig   *config.NacosConfig  = &config.NacosConfig{}
-	UserSrvClient proto.UserClient
-	RedisClient   redis.Cmdable
+	Trans            ut.Translator
+	ServerConfig     *config.ServerConfig = &config.ServerConfig{}
+	NacosConfig      *config.NacosConfig  = &config.NacosConfig{}
+	UserSrvClient    proto.UserClient
+	AddressSrvClient proto.AddressClient
+	RedisClient      redis.Cmdable
+	SmsSender        sms.Sender
 )
\ No newline at end of file
@@ -1,6 +1,9 @@
 package initialize
 
 import (
+	"go.uber.org/zap"
+
+	"mxshop_api/user_web/global"
 	"mxshop_api/user_web/middlewares"
 	"mxshop_api/user_web/router"
 
@@ -9,6 +12,10 @@ import (
 
 func Routers() *gin.Engine {
 	Router := gin.Default()
+	// 短信的频率限制按照ip计算，不能直接相信客户端传过来的X-Forwarded-For
+	if err := Router.SetTrustedProxies(global.ServerConfig.TrustedProxies); err != nil {
+		zap.S().Fatalf("[Routers] 设置 【信任的代理】失败: %s", err.Error())
+	}
 
 	// 健康检查
 	Router.GET("/health", func(c *gin.Context) {
@@ -19,6 +26,7 @@ func Routers() *gin.Engine {
 	ApiGroup := Router.Group("u/v1")
 
 	router.InitUserRouter(ApiGroup)
+	router.InitAddressRouter(ApiGroup)
 	router.InitBaseRouter(ApiGroup)
 	return Router
 }
@@ -0,0 +1,61 @@
+package initialize
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"mxshop_api/user_web/global"
+	"mxshop_api/user_web/sms"
+)
+
+// InitSmsSender 根据配置选择发送短信验证码的方式
+func InitSmsSender() {
+	cfg := &global.ServerConfig.SmsInfo
+	if cfg.Region == "" {
+		cfg.Region = "cn-beijing"
+	}
+	if cfg.SignName == "" {
+		cfg.SignName = "慕学在线"
+	}
+	if cfg.MobileMinute <= 0 {
+		cfg.MobileMinute = 1
+	}
+	if cfg.MobileDay <= 0 {
+		cfg.MobileDay = 10
+	}
+	if cfg.IpMinute <= 0 {
+		cfg.IpMinute = 5
+	}
+	if cfg.IpDay <= 0 {
+		cfg.IpDay = 50
+	}
+
+	// 没有配置类型也没有配置阿里云的key时使用本地发送，方便开发环境直接启动
+	if cfg.Type == "" && cfg.ApiKey == "" {
+		zap.S().Warn("[InitSmsSender] 没有配置短信的key，验证码不会真正发送")
+		cfg.Type = "local"
+	}
+
+	var err error
+	switch cfg.Type {
+	case "", "aliyun":
+		// 没有单独配置的用途使用原来的验证码模板
+		templates := map[string]string{}
+		for _, purpose := range []string{sms.PurposeRegister, sms.PurposeLogin, sms.PurposeReset} {
+			templates[purpose] = "SMS_181850725"
+		}
+		for purpose, template := range cfg.Templates {
+			templates[purpose] = template
+		}
+		global.SmsSender, err = sms.NewAliyun(cfg.Region, cfg.ApiKey, cfg.ApiSecret, cfg.SignName, templates)
+	case "local":
+		global.SmsSender = sms.NewLocal(cfg.File)
+	default:
+		err = fmt.Errorf("不支持的短信类型: %s", cfg.Type)
+	}
+	if err != nil {
+		zap.S().Fatalf("[InitSmsSender] 初始化 【短信】失败: %s", err.Error())
+	}
+	zap.S().Infof("[InitSmsSender] 短信: %s", cfg.Type)
+}
@@ -23,4 +23,6 @@ func InitSrvConn() {
 	zap.S().Info("[InitSrvConn] 连接 【用户服务成功】")
 	userSrvClient := proto.NewUserClient(userConn)
 	global.UserSrvClient = userSrvClient
+	// 收货地址也在用户服务中
+	global.AddressSrvClient = proto.NewAddressClient(userConn)
 }
\ No newline at end of file
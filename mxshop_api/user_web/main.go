@@ -30,6 +30,7 @@ func main() {
 
 	//4. 初始化RedisClient
 	initialize.InitRedisClient()
+	initialize.InitSmsSender()
 
 	//4. 初始化翻译
 	if err := initialize.InitTrans("zh"); err != nil {
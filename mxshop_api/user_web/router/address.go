@@ -0,0 +1,19 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"mxshop_api/user_web/api"
+	"mxshop_api/user_web/middlewares"
+)
+
+// InitAddressRouter 用户的收货地址，只能操作自己的地址
+func InitAddressRouter(Router *gin.RouterGroup) {
+	AddressRouter := Router.Group("address").Use(middlewares.JWTAuth())
+	{
+		AddressRouter.GET("", api.AddressList)                  // 收货地址列表
+		AddressRouter.POST("", api.NewAddress)                  // 新建收货地址
+		AddressRouter.PUT("/:id", api.UpdateAddress)            // 修改收货地址
+		AddressRouter.DELETE("/:id", api.DeleteAddress)         // 删除收货地址
+		AddressRouter.PATCH("/:id/default", api.DefaultAddress) // 设置默认地址
+	}
+}
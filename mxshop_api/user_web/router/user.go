@@ -13,6 +13,8 @@ func InitUserRouter(Router *gin.RouterGroup) {
 	{
 		UserRouter.GET("list", middlewares.JWTAuth(), middlewares.IsAdminAuth(), api.GetUserList)
 		UserRouter.POST("pwd_login", api.PassWordLogin)
+		UserRouter.POST("sms_login", api.SmsLogin) // 短信验证码登录，没有注册的手机号自动注册
 		UserRouter.POST("register", api.Register)
+		UserRouter.POST("reset_password", api.ResetPassword) // 通过短信验证码找回密码
 	}
 }
\ No newline at end of file
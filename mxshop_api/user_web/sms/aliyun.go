@@ -0,0 +1,52 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dysmsapi"
+)
+
+// Aliyun 阿里云短信服务
+type Aliyun struct {
+	client    *dysmsapi.Client
+	SignName  string            // 阿里云验证过的签名
+	Templates map[string]string // 验证码用途对应的模板号
+}
+
+func NewAliyun(region, apiKey, apiSecret, signName string, templates map[string]string) (*Aliyun, error) {
+	if apiKey == "" || apiSecret == "" {
+		return nil, errors.New("阿里云短信没有配置key")
+	}
+	client, err := dysmsapi.NewClientWithAccessKey(region, apiKey, apiSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &Aliyun{client: client, SignName: signName, Templates: templates}, nil
+}
+
+func (a *Aliyun) Send(ctx context.Context, mobile, code, purpose string) error {
+	template, ok := a.Templates[purpose]
+	if !ok {
+		return fmt.Errorf("没有配置%s验证码的短信模板", purpose)
+	}
+	param, _ := json.Marshal(map[string]string{"code": code})
+
+	request := dysmsapi.CreateSendSmsRequest()
+	request.Scheme = "https"
+	request.PhoneNumbers = mobile
+	request.SignName = a.SignName
+	request.TemplateCode = template
+	request.TemplateParam = string(param)
+	response, err := a.client.SendSms(request)
+	if err != nil {
+		return err
+	}
+	// 请求成功时Code为OK，其他的值是发送失败的原因，例如触发了阿里云的流控
+	if response.Code != "OK" {
+		return fmt.Errorf("阿里云短信发送失败: %s %s", response.Code, response.Message)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Local 不发送短信，把验证码写到文件或者日志中，用于开发和测试
+type Local struct {
+	File string // 写入的文件，为空时写到日志中
+
+	mu sync.Mutex
+}
+
+func NewLocal(file string) *Local {
+	return &Local{File: file}
+}
+
+func (l *Local) Send(ctx context.Context, mobile, code, purpose string) error {
+	if l.File == "" {
+		zap.S().Infof("[sms] 发送%s验证码 %s 到 %s", purpose, code, mobile)
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f, err := os.OpenFile(l.File, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(f, "%s %s %s %s\n", time.Now().Format("2006-01-02 15:04:05"), mobile, purpose, code); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,16 @@
+package sms
+
+import "context"
+
+// 验证码的用途，不同用途的验证码分开保存，注册的验证码不能用来登录或者找回密码
+const (
+	PurposeRegister = "register"
+	PurposeLogin    = "login"
+	PurposeReset    = "reset"
+)
+
+// Sender 发送短信验证码
+type Sender interface {
+	// Send 发送验证码，purpose用来选择短信模板，发送失败时返回错误
+	Send(ctx context.Context, mobile, code, purpose string) error
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"mxshop_api/user_web/api"
+	"mxshop_api/user_web/sms"
+)
+
+/*
+	短信发送的测试，本地发送使用临时文件，阿里云只测试配置检查，不依赖外部服务
+*/
+
+// TestLocal 验证码按照发送的顺序写入文件
+func TestLocal() {
+	dir, err := os.MkdirTemp("", "user-sms")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "sms.log")
+	var sender sms.Sender = sms.NewLocal(file)
+	if err := sender.Send(context.Background(), "13800000000", "123456", sms.PurposeRegister); err != nil {
+		panic(err)
+	}
+	if err := sender.Send(context.Background(), "13800000000", "654321", sms.PurposeLogin); err != nil {
+		panic(err)
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		panic(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], "13800000000 register 123456") ||
+		!strings.HasSuffix(lines[1], "13800000000 login 654321") {
+		panic(fmt.Sprintf("写入的内容不正确: %q", lines))
+	}
+}
+
+// TestAliyun 没有配置key和模板时返回错误
+func TestAliyun() {
+	if _, err := sms.NewAliyun("cn-beijing", "", "", "慕学在线", nil); err == nil {
+		panic("没有配置key应该返回错误")
+	}
+	sender, err := sms.NewAliyun("cn-beijing", "key", "secret", "慕学在线", map[string]string{
+		sms.PurposeRegister: "SMS_1",
+	})
+	if err != nil {
+		panic(err)
+	}
+	if err := sender.Send(context.Background(), "13800000000", "123456", sms.PurposeReset); err == nil {
+		panic("没有配置模板的用途应该返回错误")
+	}
+}
+
+// TestGenerateSmsCode 验证码是指定长度的数字，连续生成的验证码基本不重复
+func TestGenerateSmsCode() {
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		code, err := api.GenerateSmsCode(6)
+		if err != nil {
+			panic(err)
+		}
+		if len(code) != 6 || strings.Trim(code, "0123456789") != "" {
+			panic(fmt.Sprintf("验证码格式不正确: %s", code))
+		}
+		seen[code] = true
+	}
+	if len(seen) < 90 {
+		panic(fmt.Sprintf("100个验证码中只有%d个不同", len(seen)))
+	}
+}
+
+func main() {
+	TestLocal()
+	TestAliyun()
+	TestGenerateSmsCode()
+	fmt.Println("ok")
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_api/user_web/global"
+	"mxshop_api/user_web/initialize"
+	"mxshop_api/user_web/proto"
+	"mxshop_api/user_web/sms"
+	myvalidator "mxshop_api/user_web/validator"
+)
+
+/*
+	短信验证码的测试，验证码写到临时文件中，频率限制和验证码保存在本地的redis中，用户服务使用进程内的fake
+	每次运行使用随机的手机号和ip，重复运行不受之前的计数影响
+*/
+
+// userClient 只实现了短信登录用到的查询和注册
+// raced中的手机号模拟同时注册：注册时发现别的请求已经注册了，返回AlreadyExists
+type userClient struct {
+	proto.UserClient
+	users   map[string]*proto.UserInfoResponse
+	raced   map[string]bool
+	creates int
+}
+
+func (c *userClient) GetUserByMobile(ctx context.Context, in *proto.MobileRequest, opts ...grpc.CallOption) (*proto.UserInfoResponse, error) {
+	user, ok := c.users[in.Mobile]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "用户不存在")
+	}
+	return user, nil
+}
+
+func (c *userClient) CreateUser(ctx context.Context, in *proto.CreateUserInfo, opts ...grpc.CallOption) (*proto.UserInfoResponse, error) {
+	c.creates++
+	if _, ok := c.users[in.Mobile]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "用户已存在")
+	}
+	user := &proto.UserInfoResponse{Id: int32(len(c.users) + 1), Mobile: in.Mobile, NickName: in.NickName, PassWord: in.PassWord}
+	c.users[in.Mobile] = user
+	if c.raced[in.Mobile] {
+		return nil, status.Errorf(codes.AlreadyExists, "用户已存在")
+	}
+	return user, nil
+}
+
+var (
+	router  *gin.Engine
+	smsFile string
+	users   *userClient
+)
+
+func Init() {
+	gin.SetMode(gin.TestMode)
+	initialize.InitLogger()
+	global.ServerConfig.RedisInfo.Host = "127.0.0.1"
+	global.ServerConfig.RedisInfo.Port = 6379
+	initialize.InitRedisClient()
+
+	dir, err := os.MkdirTemp("", "user-smscode")
+	if err != nil {
+		panic(err)
+	}
+	smsFile = filepath.Join(dir, "sms.log")
+	global.ServerConfig.SmsInfo.Type = "local"
+	global.ServerConfig.SmsInfo.File = smsFile
+	initialize.InitSmsSender()
+
+	if err := initialize.InitTrans("zh"); err != nil {
+		panic(err)
+	}
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		_ = v.RegisterValidation("mobile", myvalidator.ValidateMobile)
+	}
+	global.ServerConfig.JWTInfo.AccessKey = "test-access"
+	global.ServerConfig.JWTInfo.RefreshKey = "test-refresh"
+	global.ServerConfig.JWTInfo.AccessExpire = time.Hour
+	global.ServerConfig.JWTInfo.RefreshExpire = time.Hour
+	users = &userClient{users: map[string]*proto.UserInfoResponse{}, raced: map[string]bool{}}
+	global.UserSrvClient = users
+	router = initialize.Routers()
+}
+
+func randomMobile() string {
+	return fmt.Sprintf("138%08d", rand.Intn(100000000))
+}
+
+func randomIP() string {
+	return fmt.Sprintf("10.%d.%d.%d", rand.Intn(256), rand.Intn(256), rand.Intn(256))
+}
+
+func post(path, ip string, form url.Values, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.RemoteAddr = ip + ":12345"
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func sendSms(mobile, ip, smsType string) int {
+	return post("/u/v1/base/send_sms", ip, url.Values{"mobile": {mobile}, "type": {smsType}}, nil).Code
+}
+
+// lastCode 文件中最后一次发送给这个手机号的这种用途的验证码
+func lastCode(mobile, purpose string) string {
+	data, err := os.ReadFile(smsFile)
+	if err != nil {
+		panic(err)
+	}
+	code := ""
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 5 && fields[2] == mobile && fields[3] == purpose {
+			code = fields[4]
+		}
+	}
+	if code == "" {
+		panic(fmt.Sprintf("没有发送%s验证码到%s", purpose, mobile))
+	}
+	return code
+}
+
+// TestMobileLimit 同一个手机号每分钟只能发送一次，第二次返回429
+func TestMobileLimit() {
+	global.ServerConfig.SmsInfo.MobileMinute = 1
+	mobile := randomMobile()
+	if code := sendSms(mobile, randomIP(), "1"); code != http.StatusOK {
+		panic(fmt.Sprintf("第一次发送应该成功，实际返回 %d", code))
+	}
+	if code := sendSms(mobile, randomIP(), "1"); code != http.StatusTooManyRequests {
+		panic(fmt.Sprintf("一分钟内第二次发送应该返回429，实际返回 %d", code))
+	}
+	// 其他手机号不受影响
+	if code := sendSms(randomMobile(), randomIP(), "1"); code != http.StatusOK {
+		panic(fmt.Sprintf("其他手机号应该可以发送，实际返回 %d", code))
+	}
+}
+
+// TestIpLimit 同一个ip超过限制之后返回429，伪造X-Forwarded-For不能绕过
+func TestIpLimit() {
+	global.ServerConfig.SmsInfo.IpMinute = 2
+	defer func() { global.ServerConfig.SmsInfo.IpMinute = 5 }()
+	ip := randomIP()
+	for i := 0; i < 2; i++ {
+		if code := sendSms(randomMobile(), ip, "1"); code != http.StatusOK {
+			panic(fmt.Sprintf("第%d次发送应该成功，实际返回 %d", i+1, code))
+		}
+	}
+	w := post("/u/v1/base/send_sms", ip, url.Values{"mobile": {randomMobile()}, "type": {"1"}},
+		map[string]string{"X-Forwarded-For": randomIP()})
+	if w.Code != http.StatusTooManyRequests {
+		panic(fmt.Sprintf("伪造X-Forwarded-For之后应该仍然返回429，实际返回 %d", w.Code))
+	}
+}
+
+// TestPurpose 注册的验证码不能用来登录
+func TestPurpose() {
+	mobile := randomMobile()
+	if code := sendSms(mobile, randomIP(), "1"); code != http.StatusOK {
+		panic(fmt.Sprintf("发送注册验证码应该成功，实际返回 %d", code))
+	}
+	code := lastCode(mobile, sms.PurposeRegister)
+	if w := smsLogin(mobile, code); w.Code != http.StatusBadRequest {
+		panic(fmt.Sprintf("注册的验证码不能用来登录，实际返回 %d %s", w.Code, w.Body.String()))
+	}
+}
+
+func smsLogin(mobile, code string) *httptest.ResponseRecorder {
+	return post("/u/v1/user/sms_login", randomIP(), url.Values{"mobile": {mobile}, "code": {code}}, nil)
+}
+
+// wrongCode 和正确的验证码每一位都不同
+func wrongCode(code string) string {
+	b := []byte(code)
+	for i := range b {
+		b[i] = '0' + (b[i]-'0'+1)%10
+	}
+	return string(b)
+}
+
+// TestSmsLogin 没有注册的手机号自动注册，同一个验证码第二次使用失败
+func TestSmsLogin() {
+	mobile := randomMobile()
+	if code := sendSms(mobile, randomIP(), "2"); code != http.StatusOK {
+		panic(fmt.Sprintf("发送登录验证码应该成功，实际返回 %d", code))
+	}
+	code := lastCode(mobile, sms.PurposeLogin)
+
+	w := smsLogin(mobile, code)
+	if w.Code != http.StatusOK {
+		panic(fmt.Sprintf("第一次登录应该成功，实际返回 %d %s", w.Code, w.Body.String()))
+	}
+	user, ok := users.users[mobile]
+	if !ok || user.PassWord == "" || user.PassWord == mobile {
+		panic("没有注册的手机号应该使用随机密码自动注册")
+	}
+	var rsp struct {
+		Id          int32  `json:"id"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &rsp); err != nil || rsp.Id != user.Id || rsp.AccessToken == "" {
+		panic(fmt.Sprintf("登录返回的用户不正确: %s", w.Body.String()))
+	}
+
+	if w := smsLogin(mobile, code); w.Code != http.StatusBadRequest {
+		panic(fmt.Sprintf("验证码只能使用一次，第二次登录应该返回400，实际返回 %d", w.Code))
+	}
+}
+
+// TestWrongAttempts 输错的次数达到上限时验证码被删除，之后正确的验证码也不能使用
+func TestWrongAttempts() {
+	global.ServerConfig.SmsMaxAttempts = 3
+	defer func() { global.ServerConfig.SmsMaxAttempts = 0 }()
+	mobile := randomMobile()
+	if code := sendSms(mobile, randomIP(), "2"); code != http.StatusOK {
+		panic(fmt.Sprintf("发送登录验证码应该成功，实际返回 %d", code))
+	}
+	code := lastCode(mobile, sms.PurposeLogin)
+
+	for i := 1; i <= 3; i++ {
+		w := smsLogin(mobile, wrongCode(code))
+		exhausted := strings.Contains(w.Body.String(), "次数太多")
+		if w.Code != http.StatusBadRequest || exhausted != (i == 3) {
+			panic(fmt.Sprintf("第%d次输错返回不正确: %d %s", i, w.Code, w.Body.String()))
+		}
+	}
+	if w := smsLogin(mobile, code); w.Code != http.StatusBadRequest {
+		panic(fmt.Sprintf("输错次数太多之后验证码应该失效，实际返回 %d", w.Code))
+	}
+	if _, ok := users.users[mobile]; ok {
+		panic("验证码没有通过时不应该注册用户")
+	}
+}
+
+// TestSmsLoginRaced 注册时手机号已经被同时的请求注册，使用已经注册的用户登录
+func TestSmsLoginRaced() {
+	mobile := randomMobile()
+	users.raced[mobile] = true
+	if code := sendSms(mobile, randomIP(), "2"); code != http.StatusOK {
+		panic(fmt.Sprintf("发送登录验证码应该成功，实际返回 %d", code))
+	}
+	creates := users.creates
+	w := smsLogin(mobile, lastCode(mobile, sms.PurposeLogin))
+	if w.Code != http.StatusOK {
+		panic(fmt.Sprintf("手机号已经注册时应该使用已经注册的用户登录，实际返回 %d %s", w.Code, w.Body.String()))
+	}
+	if users.creates != creates+1 {
+		panic("应该只注册一次")
+	}
+	var rsp struct {
+		Id int32 `json:"id"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &rsp); err != nil || rsp.Id != users.users[mobile].Id {
+		panic(fmt.Sprintf("登录返回的用户不正确: %s", w.Body.String()))
+	}
+}
+
+func main() {
+	rand.Seed(time.Now().UnixNano())
+	Init()
+	TestMobileLimit()
+	TestIpLimit()
+	TestPurpose()
+	TestSmsLogin()
+	TestWrongAttempts()
+	TestSmsLoginRaced()
+	fmt.Println("ok")
+}
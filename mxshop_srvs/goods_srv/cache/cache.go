@@ -0,0 +1,109 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+/*
+	商品服务的读缓存，先读缓存，没有命中时读数据库并写入缓存，修改数据之后删除缓存
+		缓存击穿：同一个key同时只有一个请求读数据库，其他请求等待结果(singleflight)
+		缓存穿透：不存在的数据也缓存一个空值，有效期比正常的数据短
+		缓存雪崩：有效期加上随机的时间，同时写入的缓存不会同时过期
+	缓存读写失败时直接读数据库，redis不可用不影响服务
+*/
+
+// ErrNotFound load返回这个错误时缓存空值，之后的请求直接返回这个错误
+var ErrNotFound = errors.New("数据不存在")
+
+// 缓存的内容第一个字节标记是否是空值
+const (
+	markNotFound byte = 0
+	markValue    byte = 1
+)
+
+type Cache struct {
+	store       Store
+	ttl         time.Duration
+	notFoundTTL time.Duration
+	group       singleflight.Group
+	metrics     *Metrics
+}
+
+func New(store Store, ttl, notFoundTTL time.Duration) *Cache {
+	return &Cache{store: store, ttl: ttl, notFoundTTL: notFoundTTL, metrics: newMetrics()}
+}
+
+// Fetch 读取key的内容，没有命中时调用load读取并写入缓存
+// name是统计命中率时的分类，例如goods、banner
+func (c *Cache) Fetch(ctx context.Context, name, key string, load func() ([]byte, error)) ([]byte, error) {
+	value, ok, err := c.store.Get(ctx, key)
+	if err != nil {
+		c.metrics.add(name, func(counter *Counter) { counter.Errors++ })
+		zap.S().Warnf("[Cache] 读取缓存 %s 失败: %s", key, err.Error())
+	} else if ok && len(value) > 0 {
+		if value[0] == markNotFound {
+			c.metrics.add(name, func(counter *Counter) { counter.Hits++; counter.NotFound++ })
+			return nil, ErrNotFound
+		}
+		c.metrics.add(name, func(counter *Counter) { counter.Hits++ })
+		return value[1:], nil
+	}
+	c.metrics.add(name, func(counter *Counter) { counter.Misses++ })
+
+	result, err, _ := c.group.Do(key, func() (interface{}, error) {
+		c.metrics.add(name, func(counter *Counter) { counter.Loads++ })
+		value, err := load()
+		// 发起请求的一方取消之后，其他等待的请求还需要写入缓存
+		writeCtx := context.WithoutCancel(ctx)
+		if errors.Is(err, ErrNotFound) {
+			c.set(writeCtx, name, key, []byte{markNotFound}, c.notFoundTTL)
+			return nil, ErrNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+		c.set(writeCtx, name, key, append([]byte{markValue}, value...), jitter(c.ttl))
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.([]byte), nil
+}
+
+func (c *Cache) set(ctx context.Context, name, key string, value []byte, ttl time.Duration) {
+	if err := c.store.Set(ctx, key, value, ttl); err != nil {
+		c.metrics.add(name, func(counter *Counter) { counter.Errors++ })
+		zap.S().Warnf("[Cache] 写入缓存 %s 失败: %s", key, err.Error())
+	}
+}
+
+// Delete 数据修改之后删除缓存
+// 正在读取的旧数据不再共享给之后的请求
+func (c *Cache) Delete(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		c.group.Forget(key)
+	}
+	if err := c.store.Delete(ctx, keys...); err != nil {
+		zap.S().Errorf("[Cache] 删除缓存 %v 失败: %s", keys, err.Error())
+	}
+}
+
+// Metrics 每个分类的命中统计
+func (c *Cache) Metrics() map[string]Counter {
+	return c.metrics.snapshot()
+}
+
+// jitter 在有效期上增加最多10%的随机时间
+func jitter(ttl time.Duration) time.Duration {
+	if ttl < 10 {
+		return ttl
+	}
+	return ttl + time.Duration(rand.Int63n(int64(ttl/10)))
+}
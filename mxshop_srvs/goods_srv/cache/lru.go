@@ -0,0 +1,120 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// LRU 进程内有容量限制的缓存，超过容量时淘汰最久没有访问的内容
+// 放在共享缓存前面，减少访问redis的次数
+type LRU struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+	now   func() time.Time
+}
+
+type lruEntry struct {
+	key      string
+	value    []byte
+	expireAt time.Time
+}
+
+func NewLRU(size int) *LRU {
+	return &LRU{size: size, ll: list.New(), items: make(map[string]*list.Element), now: time.Now}
+}
+
+func (l *LRU) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e, ok := l.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := e.Value.(*lruEntry)
+	if !l.now().Before(entry.expireAt) {
+		l.ll.Remove(e)
+		delete(l.items, key)
+		return nil, false, nil
+	}
+	l.ll.MoveToFront(e)
+	return entry.value, true, nil
+}
+
+func (l *LRU) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	expireAt := l.now().Add(ttl)
+	if e, ok := l.items[key]; ok {
+		entry := e.Value.(*lruEntry)
+		entry.value, entry.expireAt = value, expireAt
+		l.ll.MoveToFront(e)
+		return nil
+	}
+	l.items[key] = l.ll.PushFront(&lruEntry{key: key, value: value, expireAt: expireAt})
+	for l.ll.Len() > l.size {
+		oldest := l.ll.Back()
+		l.ll.Remove(oldest)
+		delete(l.items, oldest.Value.(*lruEntry).key)
+	}
+	return nil
+}
+
+func (l *LRU) Delete(ctx context.Context, keys ...string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		if e, ok := l.items[key]; ok {
+			l.ll.Remove(e)
+			delete(l.items, key)
+		}
+	}
+	return nil
+}
+
+func (l *LRU) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.ll.Len()
+}
+
+// Tiered 两级缓存，先读本地的LRU再读共享的缓存
+// 本地缓存的有效期要短，其他实例修改数据之后，本实例最多localTTL之后读到新的内容
+type Tiered struct {
+	local    *LRU
+	remote   Store
+	localTTL time.Duration
+}
+
+func NewTiered(local *LRU, remote Store, localTTL time.Duration) *Tiered {
+	return &Tiered{local: local, remote: remote, localTTL: localTTL}
+}
+
+func (t *Tiered) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	if value, ok, _ := t.local.Get(ctx, key); ok {
+		return value, true, nil
+	}
+	value, ok, err := t.remote.Get(ctx, key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	t.local.Set(ctx, key, value, t.localTTL)
+	return value, true, nil
+}
+
+func (t *Tiered) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	localTTL := t.localTTL
+	if ttl < localTTL {
+		localTTL = ttl
+	}
+	t.local.Set(ctx, key, value, localTTL)
+	return t.remote.Set(ctx, key, value, ttl)
+}
+
+func (t *Tiered) Delete(ctx context.Context, keys ...string) error {
+	t.local.Delete(ctx, keys...)
+	return t.remote.Delete(ctx, keys...)
+}
@@ -0,0 +1,50 @@
+package cache
+
+import "sync"
+
+// Counter 一个分类的缓存统计
+type Counter struct {
+	Hits     int64 // 命中，包括命中空值
+	NotFound int64 // 命中空值
+	Misses   int64 // 没有命中
+	Loads    int64 // 读数据库的次数，同一个key同时没有命中只读一次
+	Errors   int64 // 缓存读写失败
+}
+
+// HitRate 命中率，没有请求时为0
+func (c Counter) HitRate() float64 {
+	if c.Hits+c.Misses == 0 {
+		return 0
+	}
+	return float64(c.Hits) / float64(c.Hits+c.Misses)
+}
+
+type Metrics struct {
+	mu       sync.Mutex
+	counters map[string]*Counter
+}
+
+func newMetrics() *Metrics {
+	return &Metrics{counters: make(map[string]*Counter)}
+}
+
+func (m *Metrics) add(name string, update func(counter *Counter)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	counter, ok := m.counters[name]
+	if !ok {
+		counter = &Counter{}
+		m.counters[name] = counter
+	}
+	update(counter)
+}
+
+func (m *Metrics) snapshot() map[string]Counter {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make(map[string]Counter, len(m.counters))
+	for name, counter := range m.counters {
+		result[name] = *counter
+	}
+	return result
+}
@@ -13,12 +13,45 @@ type ConsulConfig struct {
 	Port int    `mapstructure:"port" json:"port"`
 }
 
+type RedisConfig struct {
+	Host string `mapstructure:"host" json:"host"`
+	Port int    `mapstructure:"port" json:"port"`
+}
+
+// CacheConfig 商品详情、分类和轮播图的缓存，时间的单位都是秒
+type CacheConfig struct {
+	TTL             int `mapstructure:"ttl" json:"ttl"`                           // 默认300
+	NotFoundTTL     int `mapstructure:"not_found_ttl" json:"not_found_ttl"`       // 不存在的数据缓存的时间，默认30
+	LocalSize       int `mapstructure:"local_size" json:"local_size"`             // 本地缓存的条数，默认10000
+	LocalTTL        int `mapstructure:"local_ttl" json:"local_ttl"`               // 本地缓存的时间，默认10
+	MetricsInterval int `mapstructure:"metrics_interval" json:"metrics_interval"` // 输出命中率日志的间隔，0表示不输出
+}
+
+type SrvConfig struct {
+	Name string `mapstructure:"name" json:"name"`
+}
+
 type ServerConfig struct {
 	Name       string       `mapstructure:"name" json:"name"`
 	Host       string       `mapstructure:"host" json:"host"`
 	Tags       []string     `mapstructure:"tags" json:"tags"`
 	MysqlInfo  MysqlConfig  `mapstructure:"mysql" json:"mysql"`
 	ConsulInfo ConsulConfig `mapstructure:"consul" json:"consul"`
+	//没有配置redis时只使用进程内的缓存
+	RedisInfo RedisConfig `mapstructure:"redis" json:"redis"`
+	CacheInfo CacheConfig `mapstructure:"cache" json:"cache"`
+	//商品索引定时全量重建的间隔，单位秒，0表示不重建
+	SearchRebuild int `mapstructure:"search_rebuild" json:"search_rebuild"`
+	//删除分类的方式，refuse(默认)：有子分类或者商品时不能删除，cascade：连同子分类和商品一起删除
+	CategoryDelete string `mapstructure:"category_delete" json:"category_delete"`
+	//按照收藏记录修正商品收藏数的间隔，单位秒，0表示不定时修正
+	FavReconcile int `mapstructure:"fav_reconcile" json:"fav_reconcile"`
+	//订单微服务的配置，评价商品时检查购买记录
+	OrderSrvInfo SrvConfig `mapstructure:"order_srv" json:"order_srv"`
+	//库存微服务的配置，删除SKU或者连同商品一起删除分类时删除对应的库存
+	InventorySrvInfo SrvConfig `mapstructure:"inventory_srv" json:"inventory_srv"`
+	//新的评价是否需要审核，false(默认)：直接审核通过，后台可以再设置为不通过
+	ReviewAudit bool `mapstructure:"review_audit" json:"review_audit"`
 }
 
 type NacosConfig struct {
@@ -6,7 +6,10 @@ import (
 	"gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
 	"log"
+	"mxshop_srvs/goods_srv/cache"
 	"mxshop_srvs/goods_srv/config"
+	"mxshop_srvs/goods_srv/proto"
+	"mxshop_srvs/goods_srv/search"
 	"os"
 	"time"
 )
@@ -15,6 +18,11 @@ var (
 	DB *gorm.DB
 	ServerConfig config.ServerConfig
 	NacosConfig  config.NacosConfig
+	SearchIndex  *search.Index // 商品的全文索引
+	Cache        *cache.Cache  // 商品详情、分类和轮播图的缓存
+
+	OrderSrvClient     proto.OrderClient     // 评价商品时查询订单
+	InventorySrvClient proto.InventoryClient // 删除SKU和商品时删除库存
 )
 
 func init() {
@@ -4,18 +4,33 @@ import (
 	"context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"mxshop_srvs/goods_srv/global"
 	"mxshop_srvs/goods_srv/model"
 	"mxshop_srvs/goods_srv/proto"
 )
 
-// BannerList 轮播图，不需要分页
+// BannerList 轮播图，不需要分页，先读缓存
 func (s *GoodsServer) BannerList(ctx context.Context, req *emptypb.Empty) (*proto.BannerListResponse, error) {
+	rsp := &proto.BannerListResponse{}
+	err := fetchProto(ctx, cacheBanner, bannersCacheKey, nil, rsp, func() (gproto.Message, error) {
+		return bannerList()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func bannerList() (*proto.BannerListResponse, error) {
 	bannerListResponse := proto.BannerListResponse{}
 
 	var banners []model.Banner
 	result := global.DB.Find(&banners)
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询轮播图失败")
+	}
 	bannerListResponse.Total = int32(result.RowsAffected)
 
 	var bannerReponses []*proto.BannerResponse
@@ -40,6 +55,7 @@ func (s *GoodsServer) CreateBanner(ctx context.Context, req *proto.BannerRequest
 	banner.Url = req.Url
 
 	global.DB.Save(&banner)
+	invalidateBanners()
 	return &proto.BannerResponse{Id: banner.ID}, nil
 }
 
@@ -47,6 +63,7 @@ func (s *GoodsServer) DeleteBanner(ctx context.Context, req *proto.BannerRequest
 	if result := global.DB.Delete(&model.Banner{}, req.Id); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.NotFound, "轮播图不存在")
 	}
+	invalidateBanners()
 	return &emptypb.Empty{}, nil
 }
 
@@ -68,5 +85,6 @@ func (s *GoodsServer) UpdateBanner(ctx context.Context, req *proto.BannerRequest
 	}
 
 	global.DB.Save(&banner)
+	invalidateBanners()
 	return &emptypb.Empty{}, nil
 }
@@ -54,12 +54,13 @@ func (s *GoodsServer) DeleteBrand(ctx context.Context, req *proto.BrandRequest)
 	if result := global.DB.Delete(&model.Brands{}, req.Id); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.NotFound, "品牌不存在")
 	}
+	invalidateGoodsOf(&model.Goods{BrandsID: req.Id})
 	return &emptypb.Empty{}, nil
 }
 
 func (s *GoodsServer) UpdateBrand(ctx context.Context, req *proto.BrandRequest) (*emptypb.Empty, error) {
 	brands := model.Brands{}
-	if result := global.DB.First(&brands); result.RowsAffected == 0 {
+	if result := global.DB.First(&brands, req.Id); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "品牌不存在")
 	}
 	if req.Name != "" {
@@ -69,5 +70,8 @@ func (s *GoodsServer) UpdateBrand(ctx context.Context, req *proto.BrandRequest)
 		brands.Logo = req.Logo
 	}
 	global.DB.Save(&brands)
+	// 品牌名称和logo在商品详情中返回，名称参与商品搜索
+	invalidateGoodsOf(&model.Goods{BrandsID: brands.ID})
+	indexGoods(&model.Goods{BrandsID: brands.ID})
 	return &emptypb.Empty{}, nil
 }
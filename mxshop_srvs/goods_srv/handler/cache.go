@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+
+	"mxshop_srvs/goods_srv/cache"
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/model"
+)
+
+// 缓存命中率统计的分类
+const (
+	cacheGoods    = "goods"
+	cacheBanner   = "banner"
+	cacheCategory = "category"
+)
+
+const (
+	bannersCacheKey    = "goods_srv:banners"
+	categoriesCacheKey = "goods_srv:categories"
+)
+
+func goodsCacheKey(id int32) string {
+	return fmt.Sprintf("goods_srv:goods:%d", id)
+}
+
+// fetchCached 通过缓存读取，load返回NotFound时缓存空值，之后直接返回notFound
+// 没有初始化缓存时直接调用load
+func fetchCached(ctx context.Context, name, key string, notFound error, load func() ([]byte, error)) ([]byte, error) {
+	if global.Cache == nil {
+		return load()
+	}
+	data, err := global.Cache.Fetch(ctx, name, key, func() ([]byte, error) {
+		data, err := load()
+		if status.Code(err) == codes.NotFound {
+			return nil, cache.ErrNotFound
+		}
+		return data, err
+	})
+	if errors.Is(err, cache.ErrNotFound) {
+		return nil, notFound
+	}
+	return data, err
+}
+
+// fetchProto 通过缓存读取proto消息，结果写入msg
+func fetchProto(ctx context.Context, name, key string, notFound error, msg gproto.Message, load func() (gproto.Message, error)) error {
+	data, err := fetchCached(ctx, name, key, notFound, func() ([]byte, error) {
+		value, err := load()
+		if err != nil {
+			return nil, err
+		}
+		return gproto.Marshal(value)
+	})
+	if err != nil {
+		return err
+	}
+	if err := gproto.Unmarshal(data, msg); err != nil {
+		return status.Errorf(codes.Internal, "读取缓存失败")
+	}
+	return nil
+}
+
+func deleteCache(keys ...string) {
+	if global.Cache != nil && len(keys) > 0 {
+		global.Cache.Delete(context.Background(), keys...)
+	}
+}
+
+// invalidateGoods 商品修改之后删除商品详情的缓存
+func invalidateGoods(ids ...int32) {
+	keys := make([]string, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, goodsCacheKey(id))
+	}
+	deleteCache(keys...)
+}
+
+// invalidateGoodsOf 品牌或者分类修改之后删除相关商品的详情缓存
+func invalidateGoodsOf(query *model.Goods) {
+	var ids []int32
+	global.DB.Model(&model.Goods{}).Where(query).Pluck("id", &ids)
+	for start := 0; start < len(ids); start += 500 {
+		end := start + 500
+		if end > len(ids) {
+			end = len(ids)
+		}
+		invalidateGoods(ids[start:end]...)
+	}
+}
+
+func invalidateBanners() {
+	deleteCache(bannersCacheKey)
+}
@@ -2,11 +2,12 @@ package handler
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"mxshop_srvs/goods_srv/global"
 	"mxshop_srvs/goods_srv/model"
 	"mxshop_srvs/goods_srv/proto"
@@ -35,12 +36,37 @@ func (s *GoodsServer) GetAllCategorysList(context.Context, *emptypb.Empty) (*pro
 		}
 	]
 	*/
-	var categorys []model.Category
-	// SubCategory      []*Category `gorm:"foreignKey:ParentCategoryID;references:ID" json:"sub_category"`
-	// 配置指明了外键后，可以使用Preload预加载，来把品牌的子分类也取出来
-	global.DB.Where(&model.Category{Level: 1}).Preload("SubCategory.SubCategory").Find(&categorys)
-	b, _ := json.Marshal(&categorys)
-	return &proto.CategoryListResponse{JsonData: string(b)}, nil
+	// 分类很少修改，整棵树缓存在内存中，修改分类时失效
+	snapshot, err := loadCategories()
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CategoryListResponse{
+		Total:    int32(len(snapshot.tree)),
+		Tree:     snapshot.tree,
+		JsonData: snapshot.jsonData,
+	}, nil
+}
+
+// CategoryBreadcrumbs 分类或者商品所在分类的路径，从一级分类开始
+func (s *GoodsServer) CategoryBreadcrumbs(ctx context.Context, req *proto.BreadcrumbsRequest) (*proto.BreadcrumbsResponse, error) {
+	categoryId := req.CategoryId
+	if req.GoodsId != 0 {
+		var goods model.Goods
+		if result := global.DB.Select("id", "category_id").First(&goods, req.GoodsId); result.RowsAffected == 0 {
+			return nil, status.Errorf(codes.NotFound, "商品不存在")
+		}
+		categoryId = goods.CategoryID
+	}
+	snapshot, err := loadCategories()
+	if err != nil {
+		return nil, err
+	}
+	path, ok := snapshot.breadcrumbs(categoryId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "商品分类不存在")
+	}
+	return &proto.BreadcrumbsResponse{Data: path}, nil
 }
 
 // GetSubCategory 获取子分类
@@ -81,45 +107,108 @@ func (s *GoodsServer) GetSubCategory(ctx context.Context, req *proto.CategoryLis
 	return &categoryListResponse, nil
 }
 
+// CreateCategory 新建分类，分类的级别由父分类决定
 func (s *GoodsServer) CreateCategory(ctx context.Context, req *proto.CategoryInfoRequest) (*proto.CategoryInfoResponse, error) {
-	category := model.Category{}
+	level := int32(1)
+	if req.ParentCategory != 0 {
+		//去查询父类目是否存在
+		var parent model.Category
+		if result := global.DB.First(&parent, req.ParentCategory); result.RowsAffected == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "父分类不存在")
+		}
+		level = parent.Level + 1
+	}
+	if level > maxCategoryLevel {
+		return nil, status.Errorf(codes.InvalidArgument, "分类最多%d级", maxCategoryLevel)
+	}
+	if req.Level != 0 && req.Level != level {
+		return nil, status.Errorf(codes.InvalidArgument, "分类级别和父分类不一致")
+	}
 	cMap := map[string]interface{}{}
 	cMap["name"] = req.Name
-	cMap["level"] = req.Level
+	cMap["level"] = level
 	cMap["is_tab"] = req.IsTab
-	if req.Level != 1 {
-		//去查询父类目是否存在
+	if level != 1 {
 		cMap["parent_category_id"] = req.ParentCategory
 	}
-	tx := global.DB.Model(&model.Category{}).Create(cMap)
-	fmt.Println(tx)
-	return &proto.CategoryInfoResponse{Id: int32(category.ID)}, nil
+	if result := global.DB.Model(&model.Category{}).Create(cMap); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "新建分类失败")
+	}
+	invalidateCategories()
+	// 使用map新建时自增的主键回填到map中
+	id, _ := cMap["id"].(int64)
+	return &proto.CategoryInfoResponse{
+		Id:             int32(id),
+		Name:           req.Name,
+		ParentCategory: req.ParentCategory,
+		Level:          level,
+		IsTab:          req.IsTab,
+	}, nil
 }
 
+// DeleteCategory 按照配置删除分类，有子分类或者商品时拒绝删除或者一起删除
 func (s *GoodsServer) DeleteCategory(ctx context.Context, req *proto.DeleteCategoryRequest) (*emptypb.Empty, error) {
-	if result := global.DB.Delete(&model.Category{}, req.Id); result.RowsAffected == 0 {
-		return nil, status.Errorf(codes.NotFound, "商品分类不存在")
+	var goodsIds, invIds []int32
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&model.Category{}, req.Id); result.RowsAffected == 0 {
+			return status.Errorf(codes.NotFound, "商品分类不存在")
+		}
+		var err error
+		goodsIds, invIds, err = deleteCategory(tx, req.Id)
+		return err
+	})
+	if err != nil {
+		return nil, categoryTxError("DeleteCategory", err)
+	}
+	invalidateCategories()
+	invalidateGoods(goodsIds...)
+	if global.SearchIndex != nil {
+		for _, id := range goodsIds {
+			global.SearchIndex.Remove(id)
+		}
+	}
+	// 商品已经删除，删除库存失败只记录日志，之后手动清理
+	if len(invIds) > 0 && global.InventorySrvClient != nil {
+		if _, err := global.InventorySrvClient.DeleteInv(ctx, &proto.DeleteInvInfo{GoodsIds: invIds}); err != nil {
+			zap.S().Errorw("[DeleteCategory] 删除 【库存】失败", "goods", invIds, "msg", err.Error())
+		}
 	}
 	return &emptypb.Empty{}, nil
 }
 
+// UpdateCategory 修改分类信息，修改父分类时按照移动分类处理，分类的级别不能直接修改
 func (s *GoodsServer) UpdateCategory(ctx context.Context, req *proto.CategoryInfoRequest) (*emptypb.Empty, error) {
 	var category model.Category
-	if result := global.DB.First(&category, req.Id); result.RowsAffected == 0 {
-		return nil, status.Errorf(codes.NotFound, "商品分类不存在")
-	}
-	if req.Name != "" {
-		category.Name = req.Name
-	}
-	if req.ParentCategory != 0 {
-		category.ParentCategoryID = req.ParentCategory
-	}
-	if req.Level != 0 {
-		category.Level = req.Level
-	}
-	if req.IsTab {
-		category.IsTab = req.IsTab
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&category, req.Id); result.RowsAffected == 0 {
+			return status.Errorf(codes.NotFound, "商品分类不存在")
+		}
+		if req.ParentCategory != 0 {
+			if err := moveCategory(tx, &category, req.ParentCategory); err != nil {
+				return err
+			}
+		}
+		if req.Level != 0 && req.Level != category.Level {
+			return status.Errorf(codes.InvalidArgument, "分类级别由父分类决定，不能直接修改")
+		}
+		updates := map[string]interface{}{}
+		if req.Name != "" {
+			updates["name"] = req.Name
+		}
+		if req.IsTab {
+			updates["is_tab"] = req.IsTab
+		}
+		if len(updates) == 0 {
+			return nil
+		}
+		return tx.Model(&model.Category{}).Where("id = ?", category.ID).Updates(updates).Error
+	})
+	if err != nil {
+		return nil, categoryTxError("UpdateCategory", err)
 	}
-	global.DB.Save(&category)
+	invalidateCategories()
+	// 分类名称在商品详情中返回，并且参与商品搜索
+	invalidateGoodsOf(&model.Goods{CategoryID: category.ID})
+	indexGoods(&model.Goods{CategoryID: category.ID})
 	return &emptypb.Empty{}, nil
 }
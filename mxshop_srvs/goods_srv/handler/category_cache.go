@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/model"
+	"mxshop_srvs/goods_srv/proto"
+)
+
+// categoryCacheTTL 组装好的分类树在本实例中缓存的时间，分类的数据另外缓存在共享的缓存中
+// 本实例修改分类时立即失效，其他实例修改的分类最多这么久之后可以看到
+const categoryCacheTTL = 10 * time.Second
+
+// categorySnapshot 所有分类的快照，生成之后不再修改，可以在多个请求之间共享
+type categorySnapshot struct {
+	byId     map[int32]model.Category
+	tree     []*proto.CategoryTree
+	jsonData string
+	loadedAt time.Time
+}
+
+var categoryCache struct {
+	sync.Mutex
+	snapshot *categorySnapshot
+}
+
+// loadCategories 返回缓存的分类，缓存失效之后从共享的缓存或者数据库中重新读取
+func loadCategories() (*categorySnapshot, error) {
+	categoryCache.Lock()
+	defer categoryCache.Unlock()
+	if snapshot := categoryCache.snapshot; snapshot != nil && time.Since(snapshot.loadedAt) < categoryCacheTTL {
+		return snapshot, nil
+	}
+	data, err := fetchCached(context.Background(), cacheCategory, categoriesCacheKey, nil, func() ([]byte, error) {
+		var categories []model.Category
+		if result := global.DB.Order("id").Find(&categories); result.Error != nil {
+			return nil, status.Errorf(codes.Internal, "查询商品分类失败")
+		}
+		return json.Marshal(categories)
+	})
+	if err != nil {
+		return nil, err
+	}
+	var categories []model.Category
+	if err := json.Unmarshal(data, &categories); err != nil {
+		return nil, status.Errorf(codes.Internal, "读取商品分类失败")
+	}
+	categoryCache.snapshot = newCategorySnapshot(categories)
+	return categoryCache.snapshot, nil
+}
+
+// invalidateCategories 分类修改之后让缓存失效
+func invalidateCategories() {
+	categoryCache.Lock()
+	categoryCache.snapshot = nil
+	categoryCache.Unlock()
+	deleteCache(categoriesCacheKey)
+}
+
+// newCategorySnapshot 在内存中组装分类树，父分类不存在的分类不在树中
+func newCategorySnapshot(categories []model.Category) *categorySnapshot {
+	snapshot := &categorySnapshot{byId: make(map[int32]model.Category, len(categories)), loadedAt: time.Now()}
+	children := make(map[int32][]model.Category)
+	for _, category := range categories {
+		snapshot.byId[category.ID] = category
+		children[category.ParentCategoryID] = append(children[category.ParentCategoryID], category)
+	}
+	for _, list := range children {
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	}
+
+	// jsonData和之前预加载三级分类时的格式一致
+	var build func(parent int32, depth int) ([]*proto.CategoryTree, []*model.Category)
+	build = func(parent int32, depth int) ([]*proto.CategoryTree, []*model.Category) {
+		if depth > maxCategoryLevel {
+			return nil, nil
+		}
+		var trees []*proto.CategoryTree
+		var models []*model.Category
+		for _, category := range children[parent] {
+			category := category
+			subTrees, subModels := build(category.ID, depth+1)
+			category.SubCategory = subModels
+			trees = append(trees, &proto.CategoryTree{
+				Id:             category.ID,
+				Name:           category.Name,
+				ParentCategory: category.ParentCategoryID,
+				Level:          category.Level,
+				IsTab:          category.IsTab,
+				SubCategorys:   subTrees,
+			})
+			models = append(models, &category)
+		}
+		return trees, models
+	}
+	tree, roots := build(0, 1)
+	if roots == nil {
+		roots = []*model.Category{}
+	}
+	b, _ := json.Marshal(roots)
+	snapshot.tree = tree
+	snapshot.jsonData = string(b)
+	return snapshot
+}
+
+// breadcrumbs 从一级分类到这个分类的路径
+func (s *categorySnapshot) breadcrumbs(categoryId int32) ([]*proto.CategoryBriefInfoResponse, bool) {
+	var path []*proto.CategoryBriefInfoResponse
+	seen := make(map[int32]bool)
+	for id := categoryId; id != 0 && !seen[id]; {
+		category, ok := s.byId[id]
+		if !ok {
+			// 中间的分类已经删除，路径不完整
+			return nil, false
+		}
+		seen[id] = true
+		path = append(path, &proto.CategoryBriefInfoResponse{Id: category.ID, Name: category.Name})
+		id = category.ParentCategoryID
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, len(path) > 0
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/model"
+	"mxshop_srvs/goods_srv/proto"
+)
+
+const maxCategoryLevel = 3 // 分类最多三级
+
+const (
+	categoryDeleteRefuse  = "refuse"
+	categoryDeleteCascade = "cascade"
+)
+
+// MoveCategory 把分类连同子分类移动到新的父分类下，重新计算子树中每个分类的级别
+// 不能移动到自己的子分类下，移动之后不能超过三级
+func (s *GoodsServer) MoveCategory(ctx context.Context, req *proto.MoveCategoryRequest) (*emptypb.Empty, error) {
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		var category model.Category
+		if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&category, req.Id); result.RowsAffected == 0 {
+			return status.Errorf(codes.NotFound, "商品分类不存在")
+		}
+		return moveCategory(tx, &category, req.ParentCategory)
+	})
+	if err != nil {
+		return nil, categoryTxError("MoveCategory", err)
+	}
+	invalidateCategories()
+	// 商品搜索的分类名称不变，不需要重建索引
+	return &emptypb.Empty{}, nil
+}
+
+// moveCategory 需要在事务中调用，category已经加锁
+func moveCategory(tx *gorm.DB, category *model.Category, parentId int32) error {
+	if parentId == category.ParentCategoryID {
+		return nil
+	}
+	ids, height, err := categorySubtree(tx, category.ID)
+	if err != nil {
+		return err
+	}
+
+	level := int32(1)
+	if parentId != 0 {
+		var parent model.Category
+		if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&parent, parentId); result.RowsAffected == 0 {
+			return status.Errorf(codes.InvalidArgument, "父分类不存在")
+		}
+		for _, id := range ids {
+			if id == parent.ID {
+				return status.Errorf(codes.InvalidArgument, "不能移动到自己或者自己的子分类下")
+			}
+		}
+		level = parent.Level + 1
+	}
+	if level+height-1 > maxCategoryLevel {
+		return status.Errorf(codes.InvalidArgument, "移动之后分类超过%d级", maxCategoryLevel)
+	}
+
+	// parent_category_id为0时保存为null，和新建一级分类一致
+	var parent interface{}
+	if parentId != 0 {
+		parent = parentId
+	}
+	if result := tx.Model(&model.Category{}).Where("id = ?", category.ID).
+		Update("parent_category_id", parent); result.Error != nil {
+		return result.Error
+	}
+	if delta := level - category.Level; delta != 0 {
+		if result := tx.Model(&model.Category{}).Where("id IN ?", ids).
+			Update("level", gorm.Expr("level + ?", delta)); result.Error != nil {
+			return result.Error
+		}
+	}
+	category.ParentCategoryID = parentId
+	category.Level = level
+	return nil
+}
+
+// categorySubtree 分类和所有子分类的id，以及子树的高度，只有自己时高度为1
+// 读取的同时给子分类加锁，移动和删除同一棵子树的请求不会同时进行
+func categorySubtree(tx *gorm.DB, id int32) ([]int32, int32, error) {
+	ids := []int32{id}
+	seen := map[int32]bool{id: true}
+	current := []int32{id}
+	height := int32(1)
+	for {
+		var children []int32
+		if result := tx.Model(&model.Category{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("parent_category_id IN ?", current).Pluck("id", &children); result.Error != nil {
+			return nil, 0, result.Error
+		}
+		var next []int32
+		for _, child := range children {
+			// 已经存在环的脏数据不会死循环
+			if !seen[child] {
+				seen[child] = true
+				next = append(next, child)
+			}
+		}
+		if len(next) == 0 {
+			return ids, height, nil
+		}
+		ids = append(ids, next...)
+		current = next
+		height++
+	}
+}
+
+// deleteCategory 按照配置删除分类，需要在事务中调用
+// 返回被一起删除的商品id，以及这些商品在库存服务中的id(没有规格的商品id和SKU的库存id)
+func deleteCategory(tx *gorm.DB, id int32) ([]int32, []int32, error) {
+	ids, _, err := categorySubtree(tx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	var goodsIds []int32
+	if result := tx.Model(&model.Goods{}).Where("category_id IN ?", ids).Pluck("id", &goodsIds); result.Error != nil {
+		return nil, nil, result.Error
+	}
+
+	if global.ServerConfig.CategoryDelete != categoryDeleteCascade {
+		if len(ids) > 1 {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "分类下还有子分类，不能删除")
+		}
+		if len(goodsIds) > 0 {
+			return nil, nil, status.Errorf(codes.FailedPrecondition, "分类下还有商品，不能删除")
+		}
+	}
+
+	// 商品和分类是逻辑删除，SKU和分类的品牌和删除SKU时一样直接删除记录，有唯一索引，逻辑删除之后不能再添加
+	var invIds []int32
+	if len(goodsIds) > 0 {
+		var skus []model.GoodsSku
+		if result := tx.Where("goods_id IN ?", goodsIds).Find(&skus); result.Error != nil {
+			return nil, nil, result.Error
+		}
+		invIds = append(invIds, goodsIds...)
+		for _, sku := range skus {
+			invIds = append(invIds, sku.InventoryKey())
+		}
+		if result := tx.Unscoped().Where("goods_id IN ?", goodsIds).Delete(&model.GoodsSku{}); result.Error != nil {
+			return nil, nil, result.Error
+		}
+		if result := tx.Where("id IN ?", goodsIds).Delete(&model.Goods{}); result.Error != nil {
+			return nil, nil, result.Error
+		}
+	}
+	if result := tx.Unscoped().Where("category_id IN ?", ids).Delete(&model.GoodsCategoryBrand{}); result.Error != nil {
+		return nil, nil, result.Error
+	}
+	if result := tx.Where("category_id IN ?", ids).Delete(&model.SpecTemplate{}); result.Error != nil {
+		return nil, nil, result.Error
+	}
+	if result := tx.Where("id IN ?", ids).Delete(&model.Category{}); result.Error != nil {
+		return nil, nil, result.Error
+	}
+	return goodsIds, invIds, nil
+}
+
+// categoryTxError 事务中返回的grpc错误原样返回，其他错误记录日志之后返回Internal
+func categoryTxError(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return status.Errorf(codes.NotFound, "商品分类不存在")
+	}
+	zap.S().Errorf("[%s] 修改分类失败: %s", method, err.Error())
+	return status.Errorf(codes.Internal, "修改分类失败")
+}
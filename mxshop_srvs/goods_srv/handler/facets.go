@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/model"
+	"mxshop_srvs/goods_srv/proto"
+)
+
+// defaultPriceBuckets 默认的价格区间分界点
+var defaultPriceBuckets = []int32{100, 500, 1000, 5000}
+
+type facetRow struct {
+	Id    int32
+	Count int32
+}
+
+// goodsFacets 在商品列表相同的筛选条件下统计品牌、分类、价格区间和标签的商品数量
+// query需要是独立的session，每次统计都从相同的筛选条件开始
+func goodsFacets(query *gorm.DB, buckets []int32) (*proto.GoodsFacets, error) {
+	if len(buckets) == 0 {
+		buckets = defaultPriceBuckets
+	}
+	for i, bound := range buckets {
+		if bound <= 0 || (i > 0 && bound <= buckets[i-1]) {
+			return nil, status.Errorf(codes.InvalidArgument, "价格区间必须是从小到大的正数")
+		}
+	}
+
+	facets := &proto.GoodsFacets{}
+
+	var brandRows []facetRow
+	if result := query.Select("brands_id as id, count(*) as count").Group("brands_id").Order("count desc, id").Scan(&brandRows); result.Error != nil {
+		return nil, result.Error
+	}
+	var brands []model.Brands
+	if len(brandRows) > 0 {
+		global.DB.Where("id in ?", facetIds(brandRows)).Find(&brands)
+	}
+	brandNames := make(map[int32]string, len(brands))
+	for _, brand := range brands {
+		brandNames[brand.ID] = brand.Name
+	}
+	for _, row := range brandRows {
+		facets.Brands = append(facets.Brands, &proto.FacetCount{Id: row.Id, Name: brandNames[row.Id], Count: row.Count})
+	}
+
+	var categoryRows []facetRow
+	if result := query.Select("category_id as id, count(*) as count").Group("category_id").Order("count desc, id").Scan(&categoryRows); result.Error != nil {
+		return nil, result.Error
+	}
+	var categories []model.Category
+	if len(categoryRows) > 0 {
+		global.DB.Where("id in ?", facetIds(categoryRows)).Find(&categories)
+	}
+	categoryNames := make(map[int32]string, len(categories))
+	for _, category := range categories {
+		categoryNames[category.ID] = category.Name
+	}
+	for _, row := range categoryRows {
+		facets.Categories = append(facets.Categories, &proto.FacetCount{Id: row.Id, Name: categoryNames[row.Id], Count: row.Count})
+	}
+
+	// 价格区间和标签在一条sql中统计，每个区间左闭右开
+	var columns []string
+	var args []interface{}
+	facets.Prices = make([]*proto.PriceBucket, 0, len(buckets)+1)
+	var min int32
+	// 最后一个区间没有上限，复制一份不修改请求中的分界点
+	bounds := append(append([]int32(nil), buckets...), 0)
+	for _, bound := range bounds {
+		if bound == 0 {
+			columns = append(columns, "coalesce(sum(case when shop_price >= ? then 1 else 0 end), 0)")
+			args = append(args, min)
+		} else {
+			columns = append(columns, "coalesce(sum(case when shop_price >= ? and shop_price < ? then 1 else 0 end), 0)")
+			args = append(args, min, bound)
+		}
+		facets.Prices = append(facets.Prices, &proto.PriceBucket{Min: min, Max: bound})
+		min = bound
+	}
+	columns = append(columns,
+		"coalesce(sum(case when is_hot then 1 else 0 end), 0)",
+		"coalesce(sum(case when is_new then 1 else 0 end), 0)",
+		"coalesce(sum(case when ship_free then 1 else 0 end), 0)",
+	)
+
+	dest := make([]interface{}, 0, len(facets.Prices)+3)
+	for _, price := range facets.Prices {
+		dest = append(dest, &price.Count)
+	}
+	dest = append(dest, &facets.IsHot, &facets.IsNew, &facets.ShipFree)
+
+	rows, err := query.Select(strings.Join(columns, ", "), args...).Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		return nil, fmt.Errorf("统计商品数量没有返回结果")
+	}
+	if err = rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return facets, nil
+}
+
+func facetIds(rows []facetRow) []int32 {
+	ids := make([]int32, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.Id)
+	}
+	return ids
+}
@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/model"
+	"mxshop_srvs/goods_srv/proto"
+)
+
+/*
+	用户收藏
+		收藏记录和商品的收藏数在同一个事务中修改，只有真正新增或者删除了记录才修改收藏数，重复请求不会重复计数
+		ReconcileFavNum按照收藏记录重新统计，修正手工改数据等原因造成的不一致
+*/
+
+const (
+	maxFavCheck    = 100 // 每次最多检查的商品数量，和列表页的每页数量一致
+	reconcileBatch = 500
+)
+
+func checkFavRequest(req *proto.UserFavRequest) error {
+	if req.UserId <= 0 {
+		return status.Errorf(codes.InvalidArgument, "用户不存在")
+	}
+	if req.GoodsId <= 0 {
+		return status.Errorf(codes.NotFound, "商品不存在")
+	}
+	return nil
+}
+
+// UserFavList 只返回还存在的商品，最近收藏的在前面
+func (s *GoodsServer) UserFavList(ctx context.Context, req *proto.UserFavRequest) (*proto.UserFavListResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户不存在")
+	}
+	// 子查询会带上goods的逻辑删除条件
+	localDB := global.DB.Model(&model.UserFav{}).
+		Where("user = ? and goods in (?)", req.UserId, global.DB.Model(&model.Goods{}).Select("id")).
+		Session(&gorm.Session{})
+
+	var total int64
+	if result := localDB.Count(&total); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询收藏失败")
+	}
+	var favs []model.UserFav
+	if result := localDB.Order("id desc").Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Find(&favs); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询收藏失败")
+	}
+
+	goodsIds := make([]int32, 0, len(favs))
+	for _, fav := range favs {
+		goodsIds = append(goodsIds, fav.Goods)
+	}
+	var goods []model.Goods
+	if len(goodsIds) > 0 {
+		if result := global.DB.Preload("Category").Preload("Brands").Where("id in ?", goodsIds).Find(&goods); result.Error != nil {
+			return nil, status.Errorf(codes.Internal, "查询收藏失败")
+		}
+	}
+	goodsMap := make(map[int32]model.Goods, len(goods))
+	for _, good := range goods {
+		goodsMap[good.ID] = good
+	}
+
+	rsp := &proto.UserFavListResponse{Total: int32(total)}
+	for _, fav := range favs {
+		good, ok := goodsMap[fav.Goods]
+		if !ok {
+			// 查询的过程中商品被删除了
+			continue
+		}
+		goodsInfo := ModelToResponse(good)
+		rsp.Data = append(rsp.Data, &proto.UserFavResponse{
+			GoodsId: fav.Goods,
+			AddTime: fav.CreatedAt.Unix(),
+			Goods:   &goodsInfo,
+		})
+	}
+	return rsp, nil
+}
+
+// AddUserFav 收藏商品，已经收藏过时不修改收藏数
+func (s *GoodsServer) AddUserFav(ctx context.Context, req *proto.UserFavRequest) (*emptypb.Empty, error) {
+	if err := checkFavRequest(req); err != nil {
+		return nil, err
+	}
+	var added bool
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if result := tx.First(&model.Goods{}, req.GoodsId); result.RowsAffected == 0 {
+			return status.Errorf(codes.NotFound, "商品不存在")
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.UserFav{User: req.UserId, Goods: req.GoodsId})
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "收藏失败")
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		added = true
+		if result := tx.Model(&model.Goods{}).Where("id = ?", req.GoodsId).
+			Update("fav_num", gorm.Expr("fav_num + 1")); result.Error != nil {
+			return status.Errorf(codes.Internal, "收藏失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if added {
+		invalidateGoods(req.GoodsId)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteUserFav 取消收藏，商品已经删除时也可以取消
+func (s *GoodsServer) DeleteUserFav(ctx context.Context, req *proto.UserFavRequest) (*emptypb.Empty, error) {
+	if err := checkFavRequest(req); err != nil {
+		return nil, err
+	}
+	var deleted bool
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().Where(&model.UserFav{User: req.UserId, Goods: req.GoodsId}).Delete(&model.UserFav{})
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "取消收藏失败")
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		deleted = true
+		if result := tx.Unscoped().Model(&model.Goods{}).Where("id = ? and fav_num > 0", req.GoodsId).
+			Update("fav_num", gorm.Expr("fav_num - 1")); result.Error != nil {
+			return status.Errorf(codes.Internal, "取消收藏失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if deleted {
+		invalidateGoods(req.GoodsId)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CheckUserFav 返回goodsIds中已经收藏的商品
+func (s *GoodsServer) CheckUserFav(ctx context.Context, req *proto.UserFavCheckRequest) (*proto.UserFavCheckResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户不存在")
+	}
+	if len(req.GoodsIds) > maxFavCheck {
+		return nil, status.Errorf(codes.InvalidArgument, "每次最多查询%d个商品", maxFavCheck)
+	}
+	rsp := &proto.UserFavCheckResponse{}
+	if len(req.GoodsIds) == 0 {
+		return rsp, nil
+	}
+	if result := global.DB.Model(&model.UserFav{}).Where("user = ? and goods in ?", req.UserId, req.GoodsIds).
+		Pluck("goods", &rsp.GoodsIds); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询收藏失败")
+	}
+	return rsp, nil
+}
+
+// ReconcileFavNum 按照收藏记录重新统计收藏数，goodsIds为空时分批检查所有的商品
+func (s *GoodsServer) ReconcileFavNum(ctx context.Context, req *proto.ReconcileFavRequest) (*proto.ReconcileFavResponse, error) {
+	rsp := &proto.ReconcileFavResponse{}
+	if len(req.GoodsIds) > 0 {
+		for start := 0; start < len(req.GoodsIds); start += reconcileBatch {
+			end := start + reconcileBatch
+			if end > len(req.GoodsIds) {
+				end = len(req.GoodsIds)
+			}
+			if err := reconcileFavNum(req.GoodsIds[start:end], rsp); err != nil {
+				return nil, err
+			}
+		}
+		return rsp, nil
+	}
+
+	var lastId int32
+	for {
+		var ids []int32
+		if result := global.DB.Model(&model.Goods{}).Where("id > ?", lastId).Order("id").Limit(reconcileBatch).
+			Pluck("id", &ids); result.Error != nil {
+			return nil, status.Errorf(codes.Internal, "查询商品失败")
+		}
+		if len(ids) == 0 {
+			return rsp, nil
+		}
+		if err := reconcileFavNum(ids, rsp); err != nil {
+			return nil, err
+		}
+		lastId = ids[len(ids)-1]
+	}
+}
+
+// ReconcileAllFavNum 定时任务调用，检查所有的商品
+func ReconcileAllFavNum() (*proto.ReconcileFavResponse, error) {
+	return (&GoodsServer{}).ReconcileFavNum(context.Background(), &proto.ReconcileFavRequest{})
+}
+
+func reconcileFavNum(goodsIds []int32, rsp *proto.ReconcileFavResponse) error {
+	var goods []model.Goods
+	if result := global.DB.Select("id", "fav_num").Where("id in ?", goodsIds).Find(&goods); result.Error != nil {
+		return status.Errorf(codes.Internal, "查询商品失败")
+	}
+	var counts []struct {
+		Goods int32
+		Total int32
+	}
+	if result := global.DB.Model(&model.UserFav{}).Select("goods, count(*) as total").
+		Where("goods in ?", goodsIds).Group("goods").Scan(&counts); result.Error != nil {
+		return status.Errorf(codes.Internal, "统计收藏失败")
+	}
+	countMap := make(map[int32]int32, len(counts))
+	for _, count := range counts {
+		countMap[count.Goods] = count.Total
+	}
+
+	var fixed []int32
+	for _, good := range goods {
+		rsp.Checked++
+		total := countMap[good.ID]
+		if good.FavNum == total {
+			continue
+		}
+		// 只在收藏数没有被同时修改时更新，被修改了下次再检查
+		result := global.DB.Model(&model.Goods{}).Where("id = ? and fav_num = ?", good.ID, good.FavNum).
+			Update("fav_num", total)
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "修正收藏数失败")
+		}
+		if result.RowsAffected == 1 {
+			fixed = append(fixed, good.ID)
+		}
+	}
+	rsp.Fixed += int32(len(fixed))
+	invalidateGoods(fixed...)
+	return nil
+}
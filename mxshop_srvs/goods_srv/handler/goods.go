@@ -2,10 +2,12 @@ package handler
 
 import (
 	"context"
-	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"mxshop_srvs/goods_srv/global"
 	"mxshop_srvs/goods_srv/model"
@@ -28,6 +30,7 @@ func ModelToResponse(goods model.Goods) proto.GoodsInfoResponse {
 		MarketPrice:     goods.MarketPrice,
 		ShopPrice:       goods.ShopPrice,
 		GoodsBrief:      goods.GoodsBrief,
+		GoodsDesc:       goods.GoodsDesc,
 		ShipFree:        goods.ShipFree,
 		GoodsFrontImage: goods.GoodsFrontImage,
 		IsNew:           goods.IsNew,
@@ -35,6 +38,8 @@ func ModelToResponse(goods model.Goods) proto.GoodsInfoResponse {
 		OnSale:          goods.OnSale,
 		DescImages:      goods.DescImages,
 		Images:          goods.Images,
+		Rating:          goodsRating(goods),
+		ReviewNum:       goods.ReviewNum,
 		Category: &proto.CategoryBriefInfoResponse{
 			Id:   goods.Category.ID,
 			Name: goods.Category.Name,
@@ -54,8 +59,16 @@ func (s *GoodsServer) GoodsList(ctx context.Context, req *proto.GoodsFilterReque
 	// 不要修改全局的DB，而是使用局部的localDB
 	localDB := global.DB.Model(model.Goods{})
 	// 这里开始拼接查询语句
+	// 关键词搜索使用全文索引，结果按照相关度排序
+	var searchIds []int32
 	if req.KeyWords != "" {
-		localDB = localDB.Where("name LIKE ?", "%"+req.KeyWords+"%")
+		for _, r := range global.SearchIndex.Search(req.KeyWords) {
+			searchIds = append(searchIds, r.Id)
+		}
+		if len(searchIds) == 0 {
+			return goodsListResponse, nil
+		}
+		localDB = localDB.Where("id in ?", searchIds)
 	}
 	if req.IsHot {
 		localDB = localDB.Where(model.Goods{IsHot: true})
@@ -69,33 +82,57 @@ func (s *GoodsServer) GoodsList(ctx context.Context, req *proto.GoodsFilterReque
 	if req.PriceMax > 0 {
 		localDB = localDB.Where("shop_price <= ?", req.PriceMax)
 	}
+	if req.OnSale {
+		localDB = localDB.Where(model.Goods{OnSale: true})
+	}
+	brands := req.Brands
 	if req.Brand > 0 {
-		localDB = localDB.Where("brand_id = ?", req.Brand)
+		brands = append(brands, req.Brand)
+	}
+	if len(brands) > 0 {
+		localDB = localDB.Where("brands_id in ?", brands)
 	}
 
-	//通过category去查询商品
-	// 子查询 嵌套 子查询
-	// SELECT * FROM goods WHERE category_id IN(SELECT id FROM category WHERE parent_category_id IN (SELECT id FROM category WHERE parent_category_id=1001))
-	var subQuery string
+	//通过category去查询商品，多个分类的商品合并
+	categories := req.Categories
 	if req.TopCategory > 0 {
-		var category model.Category
-		if result := global.DB.First(&category, req.TopCategory); result.RowsAffected == 0 {
-			return nil, status.Errorf(codes.NotFound, "商品分类不存在")
+		categories = append(categories, req.TopCategory)
+	}
+	if len(categories) > 0 {
+		var categoryIds []int32
+		for _, categoryId := range categories {
+			ids, err := leafCategoryIds(categoryId)
+			if err != nil {
+				return nil, err
+			}
+			categoryIds = append(categoryIds, ids...)
 		}
-		if category.Level == 1 {
-			subQuery = fmt.Sprintf("select id from category where parent_category_id in (select id from category WHERE parent_category_id=%d)", req.TopCategory)
-		} else if category.Level == 2 {
-			subQuery = fmt.Sprintf("select id from category WHERE parent_category_id=%d", req.TopCategory)
-		} else if category.Level == 3 {
-			subQuery = fmt.Sprintf("select id from category WHERE id=%d", req.TopCategory)
+		if len(categoryIds) == 0 {
+			return goodsListResponse, nil
 		}
-		localDB = localDB.Where(fmt.Sprintf("category_id in (%s)", subQuery))
+		localDB = localDB.Where("category_id in ?", categoryIds)
 	}
 
+	// 筛选条件拼接完成，之后的统计和查询都从这里开始，互相不影响
+	localDB = localDB.Session(&gorm.Session{})
+
 	var count int64
 	localDB.Count(&count)
 	goodsListResponse.Total = int32(count) //这个total的总数量一定要在分页之前完成，否则就是分页的数量了
 
+	if req.WithFacets {
+		facets, err := goodsFacets(localDB, req.PriceBuckets)
+		if err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			return nil, status.Errorf(codes.Internal, "统计商品数量失败")
+		}
+		goodsListResponse.Facets = facets
+	}
+
+	localDB = sortGoods(localDB, req.Sort, searchIds)
+
 	// 有外键需要使用 Preload
 	result := localDB.Preload("Category").Preload("Brands").Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Find(&goods)
 	if result.Error != nil {
@@ -109,6 +146,49 @@ func (s *GoodsServer) GoodsList(ctx context.Context, req *proto.GoodsFilterReque
 	return goodsListResponse, nil
 }
 
+// leafCategoryIds 分类下面所有三级分类的id，商品都挂在三级分类上
+// SELECT * FROM goods WHERE category_id IN(SELECT id FROM category WHERE parent_category_id IN (SELECT id FROM category WHERE parent_category_id=1001))
+func leafCategoryIds(categoryId int32) ([]int32, error) {
+	var category model.Category
+	if result := global.DB.First(&category, categoryId); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "商品分类不存在")
+	}
+	var ids []int32
+	switch category.Level {
+	case 1:
+		subQuery := global.DB.Model(&model.Category{}).Select("id").Where("parent_category_id = ?", categoryId)
+		global.DB.Model(&model.Category{}).Where("parent_category_id in (?)", subQuery).Pluck("id", &ids)
+	case 2:
+		global.DB.Model(&model.Category{}).Where("parent_category_id = ?", categoryId).Pluck("id", &ids)
+	default:
+		ids = []int32{categoryId}
+	}
+	return ids, nil
+}
+
+// sortGoods 商品列表的排序，排序字段相同时按照id排序保证分页稳定
+func sortGoods(db *gorm.DB, sort proto.GoodsSort, searchIds []int32) *gorm.DB {
+	switch sort {
+	case proto.GoodsSort_SORT_PRICE_ASC:
+		return db.Order("shop_price, id")
+	case proto.GoodsSort_SORT_PRICE_DESC:
+		return db.Order("shop_price desc, id")
+	case proto.GoodsSort_SORT_SOLD:
+		return db.Order("sold_num desc, id")
+	case proto.GoodsSort_SORT_CLICK:
+		return db.Order("click_num desc, id")
+	case proto.GoodsSort_SORT_NEWEST:
+		return db.Order("add_time desc, id desc")
+	}
+	// 默认和相关度排序，搜索结果已经按照相关度排好序
+	if len(searchIds) > 0 {
+		return db.Clauses(clause.OrderBy{
+			Expression: clause.Expr{SQL: "FIELD(id,?)", Vars: []interface{}{searchIds}, WithoutParentheses: true},
+		})
+	}
+	return db.Order("id")
+}
+
 // BatchGetGoods 现在用户提交订单有多个商品，你得批量查询商品的信息吧
 func (s *GoodsServer) BatchGetGoods(ctx context.Context, req *proto.BatchGoodsIdInfo) (*proto.GoodsListResponse, error) {
 	goodsListResponse := &proto.GoodsListResponse{}
@@ -124,13 +204,30 @@ func (s *GoodsServer) BatchGetGoods(ctx context.Context, req *proto.BatchGoodsId
 	return goodsListResponse, nil
 }
 
+// GetGoodsDetail 商品详情，先读缓存，修改商品、SKU、品牌和分类时删除缓存
 func (s *GoodsServer) GetGoodsDetail(ctx context.Context, req *proto.GoodInfoRequest) (*proto.GoodsInfoResponse, error) {
-	var goods model.Goods
+	rsp := &proto.GoodsInfoResponse{}
+	err := fetchProto(ctx, cacheGoods, goodsCacheKey(req.Id), status.Errorf(codes.NotFound, "商品不存在"), rsp, func() (gproto.Message, error) {
+		return goodsDetail(req.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
 
-	if result := global.DB.Preload("Category").Preload("Brands").First(&goods, req.Id); result.RowsAffected == 0 {
+func goodsDetail(id int32) (*proto.GoodsInfoResponse, error) {
+	var goods model.Goods
+	if result := global.DB.Preload("Category").Preload("Brands").First(&goods, id); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.NotFound, "商品不存在")
 	}
 	goodsInfoResponse := ModelToResponse(goods)
+	specs, skus, err := specMatrix(goods)
+	if err != nil {
+		return nil, err
+	}
+	goodsInfoResponse.Specs = specs
+	goodsInfoResponse.Skus = skus
 	return &goodsInfoResponse, nil
 }
 
@@ -144,22 +241,27 @@ func (s *GoodsServer) CreateGoods(ctx context.Context, req *proto.CreateGoodsInf
 	if result := global.DB.First(&brand, req.BrandId); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "品牌不存在")
 	}
+	media, err := checkGoodsMedia(req)
+	if err != nil {
+		return nil, err
+	}
 	// 先检查redis中是否有这个token
 	// 防止同一个token的数据重复插入到数据库中，如果redis中没有这个token则放入redis
-	// 这里没有看到图片文件是如何上传， 在微服务中 普通的文件上传已经不再使用
+	// 图片通过goods_web的上传接口保存到存储中，这里只保存图片的地址
 	goods := model.Goods{
-		Brands:      brand,
-		BrandsID:    brand.ID,
-		Category:    category,
-		CategoryID:  category.ID,
-		Name:        req.Name,
-		GoodsSn:     req.GoodsSn,
-		MarketPrice: req.MarketPrice,
-		ShopPrice:   req.ShopPrice,
-		GoodsBrief:  req.GoodsBrief,
-		ShipFree:    req.ShipFree,
-		//Images: req.Images,
-		//DescImages: req.DescImages,
+		Brands:          brand,
+		BrandsID:        brand.ID,
+		Category:        category,
+		CategoryID:      category.ID,
+		Name:            req.Name,
+		GoodsSn:         req.GoodsSn,
+		MarketPrice:     req.MarketPrice,
+		ShopPrice:       req.ShopPrice,
+		GoodsBrief:      req.GoodsBrief,
+		ShipFree:        req.ShipFree,
+		Images:          media.Images,
+		DescImages:      media.DescImages,
+		GoodsDesc:       media.GoodsDesc,
 		GoodsFrontImage: req.GoodsFrontImage,
 		IsNew:           req.IsNew,
 		IsHot:           req.IsHot,
@@ -173,6 +275,11 @@ func (s *GoodsServer) CreateGoods(ctx context.Context, req *proto.CreateGoodsInf
 		return nil, result.Error
 	}
 	tx.Commit()
+	// 新商品的id之前可能被当作不存在的商品缓存过
+	invalidateGoods(goods.ID)
+	if global.SearchIndex != nil {
+		global.SearchIndex.Add(goodsDoc(goods))
+	}
 	return &proto.GoodsInfoResponse{
 		Id: goods.ID,
 	}, nil
@@ -183,6 +290,10 @@ func (s *GoodsServer) DeleteGoods(ctx context.Context, req *proto.DeleteGoodsInf
 	if result := global.DB.Delete(&model.Goods{BaseModel: model.BaseModel{ID: req.Id}}, req.Id); result.Error != nil {
 		return nil, status.Errorf(codes.NotFound, "商品不存在")
 	}
+	invalidateGoods(req.Id)
+	if global.SearchIndex != nil {
+		global.SearchIndex.Remove(req.Id)
+	}
 	return &emptypb.Empty{}, nil
 }
 
@@ -195,10 +306,20 @@ func (s *GoodsServer) UpdateGoods(ctx context.Context, req *proto.CreateGoodsInf
 	if result := global.DB.First(&category, req.CategoryId); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "商品分类不存在")
 	}
+	if category.ID != goods.CategoryID {
+		// SKU的规格来自分类的规格模板，换分类之后规格就对不上了
+		if result := global.DB.Where(&model.GoodsSku{GoodsID: goods.ID}).First(&model.GoodsSku{}); result.RowsAffected == 1 {
+			return nil, status.Errorf(codes.FailedPrecondition, "商品已经有SKU，不能修改分类")
+		}
+	}
 	var brand model.Brands
 	if result := global.DB.First(&brand, req.BrandId); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "品牌不存在")
 	}
+	media, err := checkGoodsMedia(req)
+	if err != nil {
+		return nil, err
+	}
 	goods.Brands = brand
 	goods.BrandsID = brand.ID
 	goods.Category = category
@@ -209,8 +330,9 @@ func (s *GoodsServer) UpdateGoods(ctx context.Context, req *proto.CreateGoodsInf
 	goods.ShopPrice = req.ShopPrice
 	goods.GoodsBrief = req.GoodsBrief
 	goods.ShipFree = req.ShipFree
-	//goods.Images= req.Images
-	//goods.DescImages= req.DescImages
+	goods.Images = media.Images
+	goods.DescImages = media.DescImages
+	goods.GoodsDesc = media.GoodsDesc
 	goods.GoodsFrontImage = req.GoodsFrontImage
 	goods.IsNew = req.IsNew
 	goods.IsHot = req.IsHot
@@ -222,5 +344,9 @@ func (s *GoodsServer) UpdateGoods(ctx context.Context, req *proto.CreateGoodsInf
 		return nil, result.Error
 	}
 	tx.Commit()
+	invalidateGoods(goods.ID)
+	if global.SearchIndex != nil {
+		global.SearchIndex.Add(goodsDoc(goods))
+	}
 	return &emptypb.Empty{}, nil
 }
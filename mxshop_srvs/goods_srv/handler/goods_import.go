@@ -0,0 +1,269 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/model"
+	"mxshop_srvs/goods_srv/proto"
+)
+
+// maxImportRows 每次请求最多导入的行数，goods_web把文件分批导入
+const maxImportRows = 500
+
+// goodsImporter 一次导入请求中查询过的分类、品牌和已有的商品
+type goodsImporter struct {
+	categoryPaths map[string]int32   // 完整路径 -> 分类id
+	categoryNames map[string][]int32 // 名称 -> 分类id
+	categories    map[int32]model.Category
+	brands        map[string][]model.Brands
+	existing      map[string][]model.Goods // 商品编号 -> 已有的商品
+	seen          map[string]int32         // 商品编号 -> 第一次出现的行号
+}
+
+// ImportGoods 批量导入商品，分类和品牌通过名称查找
+// 先检查所有的行，有错误时返回每一行的错误，不保存任何一行
+func (s *GoodsServer) ImportGoods(ctx context.Context, req *proto.ImportGoodsRequest) (*proto.ImportGoodsResponse, error) {
+	if len(req.Rows) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "没有要导入的商品")
+	}
+	if len(req.Rows) > maxImportRows {
+		return nil, status.Errorf(codes.InvalidArgument, "每次最多导入%d个商品", maxImportRows)
+	}
+	importer, err := newGoodsImporter(req.Rows)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &proto.ImportGoodsResponse{}
+	goodsList := make([]model.Goods, 0, len(req.Rows))
+	for _, row := range req.Rows {
+		goods, err := importer.goods(row)
+		if err != nil {
+			rsp.Errors = append(rsp.Errors, &proto.ImportGoodsError{Line: row.Line, Msg: status.Convert(err).Message()})
+			continue
+		}
+		goodsList = append(goodsList, goods)
+	}
+	if len(rsp.Errors) > 0 {
+		return rsp, nil
+	}
+
+	if !req.DryRun {
+		tx := global.DB.Begin()
+		for i := range goodsList {
+			if result := tx.Save(&goodsList[i]); result.Error != nil {
+				tx.Rollback()
+				return nil, status.Errorf(codes.Internal, "第%d行保存失败", req.Rows[i].Line)
+			}
+		}
+		tx.Commit()
+	}
+
+	ids := make([]int32, 0, len(goodsList))
+	for i, goods := range goodsList {
+		created := importer.existing[goods.GoodsSn] == nil
+		if created {
+			rsp.Created++
+		} else {
+			rsp.Updated++
+		}
+		rsp.Goods = append(rsp.Goods, &proto.ImportGoodsResult{Line: req.Rows[i].Line, GoodsId: goods.ID, Created: created})
+		ids = append(ids, goods.ID)
+	}
+	if !req.DryRun {
+		invalidateGoods(ids...)
+		if global.SearchIndex != nil {
+			for _, goods := range goodsList {
+				global.SearchIndex.Add(goodsDoc(goods))
+			}
+		}
+	}
+	return rsp, nil
+}
+
+// newGoodsImporter 一次查出所有行用到的分类、品牌和已有的商品
+func newGoodsImporter(rows []*proto.ImportGoodsRow) (*goodsImporter, error) {
+	snapshot, err := loadCategories()
+	if err != nil {
+		return nil, err
+	}
+	importer := &goodsImporter{
+		categoryPaths: make(map[string]int32, len(snapshot.byId)),
+		categoryNames: make(map[string][]int32),
+		categories:    snapshot.byId,
+		brands:        make(map[string][]model.Brands),
+		existing:      make(map[string][]model.Goods),
+		seen:          make(map[string]int32, len(rows)),
+	}
+	for id, category := range snapshot.byId {
+		importer.categoryNames[category.Name] = append(importer.categoryNames[category.Name], id)
+		if path, ok := snapshot.breadcrumbs(id); ok {
+			names := make([]string, 0, len(path))
+			for _, c := range path {
+				names = append(names, c.Name)
+			}
+			importer.categoryPaths[strings.Join(names, "/")] = id
+		}
+	}
+
+	var brandNames, goodsSns []string
+	for _, row := range rows {
+		brandNames = append(brandNames, strings.TrimSpace(row.Brand))
+		if row.Goods != nil {
+			goodsSns = append(goodsSns, strings.TrimSpace(row.Goods.GoodsSn))
+		}
+	}
+	var brands []model.Brands
+	if result := global.DB.Where("name in ?", brandNames).Find(&brands); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询品牌失败")
+	}
+	for _, brand := range brands {
+		importer.brands[brand.Name] = append(importer.brands[brand.Name], brand)
+	}
+	var goodsList []model.Goods
+	if len(goodsSns) > 0 {
+		if result := global.DB.Where("goods_sn in ?", goodsSns).Find(&goodsList); result.Error != nil {
+			return nil, status.Errorf(codes.Internal, "查询商品失败")
+		}
+	}
+	for _, goods := range goodsList {
+		importer.existing[goods.GoodsSn] = append(importer.existing[goods.GoodsSn], goods)
+	}
+	return importer, nil
+}
+
+// category 按照完整路径或者名称查找分类，商品只能放在三级分类下
+func (im *goodsImporter) category(name string) (model.Category, error) {
+	var id int32
+	if strings.Contains(name, "/") {
+		parts := strings.Split(name, "/")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		var ok bool
+		if id, ok = im.categoryPaths[strings.Join(parts, "/")]; !ok {
+			return model.Category{}, status.Errorf(codes.InvalidArgument, "商品分类不存在: %s", name)
+		}
+	} else {
+		ids := im.categoryNames[name]
+		switch {
+		case len(ids) == 0:
+			return model.Category{}, status.Errorf(codes.InvalidArgument, "商品分类不存在: %s", name)
+		case len(ids) > 1:
+			return model.Category{}, status.Errorf(codes.InvalidArgument, "有多个分类叫%s，请使用完整的分类路径", name)
+		}
+		id = ids[0]
+	}
+	category := im.categories[id]
+	if category.Level != maxCategoryLevel {
+		return model.Category{}, status.Errorf(codes.InvalidArgument, "商品只能放在%d级分类下: %s", maxCategoryLevel, name)
+	}
+	return category, nil
+}
+
+func (im *goodsImporter) brand(name string) (model.Brands, error) {
+	brands := im.brands[name]
+	switch {
+	case len(brands) == 0:
+		return model.Brands{}, status.Errorf(codes.InvalidArgument, "品牌不存在: %s", name)
+	case len(brands) > 1:
+		return model.Brands{}, status.Errorf(codes.InvalidArgument, "有多个品牌叫%s", name)
+	}
+	return brands[0], nil
+}
+
+// goods 检查一行的内容，返回要保存的商品
+func (im *goodsImporter) goods(row *proto.ImportGoodsRow) (model.Goods, error) {
+	req := row.Goods
+	if req == nil {
+		return model.Goods{}, status.Errorf(codes.InvalidArgument, "没有商品信息")
+	}
+	req.GoodsSn = strings.TrimSpace(req.GoodsSn)
+	req.Name = strings.TrimSpace(req.Name)
+	if err := checkImportText(req); err != nil {
+		return model.Goods{}, err
+	}
+	if line, ok := im.seen[req.GoodsSn]; ok {
+		return model.Goods{}, status.Errorf(codes.InvalidArgument, "商品编号和第%d行重复", line)
+	}
+	im.seen[req.GoodsSn] = row.Line
+
+	category, err := im.category(strings.TrimSpace(row.Category))
+	if err != nil {
+		return model.Goods{}, err
+	}
+	brand, err := im.brand(strings.TrimSpace(row.Brand))
+	if err != nil {
+		return model.Goods{}, err
+	}
+	media, err := checkGoodsMedia(req)
+	if err != nil {
+		return model.Goods{}, err
+	}
+
+	var goods model.Goods
+	switch existing := im.existing[req.GoodsSn]; {
+	case len(existing) > 1:
+		return model.Goods{}, status.Errorf(codes.InvalidArgument, "商品编号%s对应多个商品，请在后台修改", req.GoodsSn)
+	case len(existing) == 1:
+		goods = existing[0]
+		if category.ID != goods.CategoryID {
+			// 和UpdateGoods一样，有SKU的商品不能换分类
+			if result := global.DB.Where(&model.GoodsSku{GoodsID: goods.ID}).First(&model.GoodsSku{}); result.RowsAffected == 1 {
+				return model.Goods{}, status.Errorf(codes.FailedPrecondition, "商品已经有SKU，不能修改分类")
+			}
+		}
+	}
+	goods.Brands = brand
+	goods.BrandsID = brand.ID
+	goods.Category = category
+	goods.CategoryID = category.ID
+	goods.Name = req.Name
+	goods.GoodsSn = req.GoodsSn
+	goods.MarketPrice = req.MarketPrice
+	goods.ShopPrice = req.ShopPrice
+	goods.GoodsBrief = req.GoodsBrief
+	goods.ShipFree = req.ShipFree
+	goods.Images = media.Images
+	goods.DescImages = media.DescImages
+	goods.GoodsDesc = media.GoodsDesc
+	goods.GoodsFrontImage = req.GoodsFrontImage
+	goods.IsNew = req.IsNew
+	goods.IsHot = req.IsHot
+	goods.OnSale = req.OnSale
+	return goods, nil
+}
+
+// checkImportText 文件中的内容没有经过表单校验，这里按照数据库字段的长度检查
+func checkImportText(req *proto.CreateGoodsInfo) error {
+	for _, field := range []struct {
+		name     string
+		value    string
+		max      int
+		required bool
+	}{
+		{"商品编号", req.GoodsSn, 50, true},
+		{"商品名称", req.Name, 50, true},
+		{"商品简介", req.GoodsBrief, 100, false},
+	} {
+		if field.required && field.value == "" {
+			return status.Errorf(codes.InvalidArgument, "%s不能为空", field.name)
+		}
+		if utf8.RuneCountInString(field.value) > field.max {
+			return status.Errorf(codes.InvalidArgument, "%s不能超过%d个字", field.name, field.max)
+		}
+	}
+	if req.ShopPrice <= 0 {
+		return status.Errorf(codes.InvalidArgument, "本店价格必须大于0")
+	}
+	if req.MarketPrice < 0 {
+		return status.Errorf(codes.InvalidArgument, "市场价格不能小于0")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_srvs/goods_srv/model"
+	"mxshop_srvs/goods_srv/proto"
+	"mxshop_srvs/goods_srv/utils"
+)
+
+const (
+	maxImages     = 10
+	maxDescImages = 30
+	maxImageUrl   = 200   // 和商品展示图的字段长度一致
+	maxImagesLen  = 4000  // 图片列表的字段长度
+	maxGoodsDesc  = 60000 // text字段最多65535字节
+)
+
+// goodsMedia 检查过的商品图片和详情
+type goodsMedia struct {
+	Images     model.GormList
+	DescImages model.GormList
+	GoodsDesc  string
+}
+
+// checkGoodsMedia 检查商品的图片地址，过滤商品详情中不安全的html
+func checkGoodsMedia(req *proto.CreateGoodsInfo) (*goodsMedia, error) {
+	if req.GoodsFrontImage != "" && (len(req.GoodsFrontImage) > maxImageUrl || !utils.IsHttpUrl(req.GoodsFrontImage)) {
+		return nil, status.Errorf(codes.InvalidArgument, "商品展示图地址不合法")
+	}
+	images, err := checkImageUrls("商品图片", req.Images, maxImages)
+	if err != nil {
+		return nil, err
+	}
+	descImages, err := checkImageUrls("商品详情图片", req.DescImages, maxDescImages)
+	if err != nil {
+		return nil, err
+	}
+	desc := utils.SanitizeHTML(req.GoodsDesc)
+	if len(desc) > maxGoodsDesc {
+		return nil, status.Errorf(codes.InvalidArgument, "商品详情太长")
+	}
+	return &goodsMedia{Images: images, DescImages: descImages, GoodsDesc: desc}, nil
+}
+
+// checkImageUrls 图片地址必须是http或者https的绝对地址，重复的地址只保留一个
+func checkImageUrls(name string, urls []string, max int) (model.GormList, error) {
+	list := make(model.GormList, 0, len(urls))
+	seen := make(map[string]bool, len(urls))
+	for _, u := range urls {
+		if len(u) > maxImageUrl || !utils.IsHttpUrl(u) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s地址不合法: %s", name, u)
+		}
+		if seen[u] {
+			continue
+		}
+		seen[u] = true
+		list = append(list, u)
+	}
+	if len(list) > max {
+		return nil, status.Errorf(codes.InvalidArgument, "%s最多%d张", name, max)
+	}
+	if b, _ := json.Marshal(list); len(b) > maxImagesLen {
+		return nil, status.Errorf(codes.InvalidArgument, "%s地址总长度超过限制", name)
+	}
+	return list, nil
+}
@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/model"
+	"mxshop_srvs/goods_srv/proto"
+)
+
+/*
+	商品评价
+		评价和订单中的商品一一对应，订单支付成功或者交易结束之后才能评价
+		审核通过的评价计入商品的ReviewNum和RatingSum，修改审核状态时在同一个事务中修改，平均星级由两者计算
+*/
+
+const (
+	maxReviewContent = 500
+	maxReviewImages  = 9
+	maxReviewReply   = 500
+)
+
+func ReviewToResponse(review model.GoodsReview) *proto.ReviewResponse {
+	rsp := &proto.ReviewResponse{
+		Id:           review.ID,
+		UserId:       review.User,
+		GoodsId:      review.Goods,
+		OrderGoodsId: review.OrderGoods,
+		Rating:       review.Rating,
+		Content:      review.Content,
+		Images:       review.Images,
+		SkuSpecs:     review.SkuSpecs,
+		Reply:        review.Reply,
+		Status:       proto.ReviewStatus(review.Status),
+		HelpfulNum:   review.HelpfulNum,
+		AddTime:      review.CreatedAt.Unix(),
+	}
+	if review.ReplyTime != nil {
+		rsp.ReplyTime = review.ReplyTime.Unix()
+	}
+	return rsp
+}
+
+// goodsRating 平均星级，保留一位小数
+func goodsRating(goods model.Goods) float32 {
+	if goods.ReviewNum <= 0 {
+		return 0
+	}
+	return float32(math.Round(float64(goods.RatingSum)*10/float64(goods.ReviewNum)) / 10)
+}
+
+func checkReview(req *proto.ReviewRequest) error {
+	if req.UserId <= 0 {
+		return status.Errorf(codes.InvalidArgument, "用户不存在")
+	}
+	if req.Rating < 1 || req.Rating > 5 {
+		return status.Errorf(codes.InvalidArgument, "评分必须是1-5星")
+	}
+	if utf8.RuneCountInString(req.Content) > maxReviewContent {
+		return status.Errorf(codes.InvalidArgument, "评价不能超过%d个字", maxReviewContent)
+	}
+	if len(req.Images) > maxReviewImages {
+		return status.Errorf(codes.InvalidArgument, "最多上传%d张图片", maxReviewImages)
+	}
+	for _, image := range req.Images {
+		if !strings.HasPrefix(image, "http://") && !strings.HasPrefix(image, "https://") || len(image) > 200 {
+			return status.Errorf(codes.InvalidArgument, "图片地址不合法")
+		}
+	}
+	return nil
+}
+
+// updateRating 审核通过的评价数量变化时修改商品的评价数和星级之和，delta为1或者-1
+func updateRating(tx *gorm.DB, goodsId, rating, delta int32) error {
+	result := tx.Unscoped().Model(&model.Goods{}).Where("id = ?", goodsId).Updates(map[string]interface{}{
+		"review_num": gorm.Expr("review_num + ?", delta),
+		"rating_sum": gorm.Expr("rating_sum + ?", rating*delta),
+	})
+	if result.Error != nil {
+		return status.Errorf(codes.Internal, "修改商品评分失败")
+	}
+	return nil
+}
+
+// ReviewList 商品详情页只查询审核通过的评价，后台可以按照状态查询
+func (s *GoodsServer) ReviewList(ctx context.Context, req *proto.ReviewFilterRequest) (*proto.ReviewListResponse, error) {
+	localDB := global.DB.Model(&model.GoodsReview{})
+	if req.GoodsId > 0 {
+		localDB = localDB.Where("goods = ?", req.GoodsId)
+	}
+	if req.UserId > 0 {
+		localDB = localDB.Where("user = ?", req.UserId)
+	}
+	if req.Status != proto.ReviewStatus_REVIEW_STATUS_UNSPECIFIED {
+		localDB = localDB.Where("status = ?", int32(req.Status))
+	}
+	localDB = localDB.Session(&gorm.Session{})
+
+	var total int64
+	if result := localDB.Count(&total); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询评价失败")
+	}
+
+	order := "id desc"
+	switch req.Sort {
+	case "", "newest":
+	case "helpful":
+		order = "helpful_num desc, id desc"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "不支持的排序方式")
+	}
+	var reviews []model.GoodsReview
+	if result := localDB.Order(order).Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Find(&reviews); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询评价失败")
+	}
+
+	rsp := &proto.ReviewListResponse{Total: int32(total)}
+	for _, review := range reviews {
+		rsp.Data = append(rsp.Data, ReviewToResponse(review))
+	}
+	return rsp, nil
+}
+
+// CreateReview 通过订单服务检查订单商品是当前用户购买的，并且订单已经完成
+func (s *GoodsServer) CreateReview(ctx context.Context, req *proto.ReviewRequest) (*proto.ReviewResponse, error) {
+	if err := checkReview(req); err != nil {
+		return nil, err
+	}
+	if req.OrderGoodsId <= 0 {
+		return nil, status.Errorf(codes.NotFound, "订单商品不存在")
+	}
+	orderGoods, err := global.OrderSrvClient.OrderGoodsDetail(ctx, &proto.OrderGoodsRequest{
+		Id:     req.OrderGoodsId,
+		UserId: req.UserId,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "订单商品不存在")
+		}
+		zap.S().Errorw("[CreateReview] 查询 【订单商品】失败", "msg", err.Error())
+		return nil, status.Errorf(codes.Internal, "查询订单失败")
+	}
+	if orderGoods.Status != proto.OrderState_TRADE_SUCCESS && orderGoods.Status != proto.OrderState_TRADE_FINISHED {
+		return nil, status.Errorf(codes.FailedPrecondition, "订单完成之后才能评价")
+	}
+
+	review := model.GoodsReview{
+		User:       req.UserId,
+		Goods:      orderGoods.Goods.GoodsId,
+		Status:     model.ReviewApproved,
+		Order:      orderGoods.Goods.OrderId,
+		OrderGoods: req.OrderGoodsId,
+		SkuSpecs:   orderGoods.Goods.SkuSpecs,
+		Rating:     req.Rating,
+		Content:    req.Content,
+		Images:     req.Images,
+	}
+	if review.Images == nil {
+		review.Images = model.GormList{}
+	}
+	if global.ServerConfig.ReviewAudit {
+		review.Status = model.ReviewPending
+	}
+	err = global.DB.Transaction(func(tx *gorm.DB) error {
+		if result := tx.First(&model.Goods{}, review.Goods); result.RowsAffected == 0 {
+			return status.Errorf(codes.NotFound, "商品不存在")
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&review)
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "评价失败")
+		}
+		if result.RowsAffected == 0 {
+			return status.Errorf(codes.AlreadyExists, "已经评价过了")
+		}
+		if review.Status == model.ReviewApproved {
+			return updateRating(tx, review.Goods, review.Rating, 1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if review.Status == model.ReviewApproved {
+		invalidateGoods(review.Goods)
+	}
+	return ReviewToResponse(review), nil
+}
+
+// ReplyReview 商家回复评价，再次回复会覆盖之前的回复
+func (s *GoodsServer) ReplyReview(ctx context.Context, req *proto.ReviewReplyRequest) (*emptypb.Empty, error) {
+	if strings.TrimSpace(req.Reply) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "回复不能为空")
+	}
+	if utf8.RuneCountInString(req.Reply) > maxReviewReply {
+		return nil, status.Errorf(codes.InvalidArgument, "回复不能超过%d个字", maxReviewReply)
+	}
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.NotFound, "评价不存在")
+	}
+	result := global.DB.Model(&model.GoodsReview{}).Where("id = ?", req.Id).Updates(map[string]interface{}{
+		"reply":      req.Reply,
+		"reply_time": time.Now(),
+	})
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "回复评价失败")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "评价不存在")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ModerateReview 修改审核状态，审核通过和取消通过时同时修改商品的评分
+func (s *GoodsServer) ModerateReview(ctx context.Context, req *proto.ReviewModerateRequest) (*emptypb.Empty, error) {
+	newStatus := int32(req.Status)
+	if newStatus != model.ReviewPending && newStatus != model.ReviewApproved && newStatus != model.ReviewRejected {
+		return nil, status.Errorf(codes.InvalidArgument, "审核状态不合法")
+	}
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.NotFound, "评价不存在")
+	}
+	var review model.GoodsReview
+	var changed bool
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		// 锁住评价，同时审核同一条评价时不会重复计数
+		if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&review, req.Id); result.RowsAffected == 0 {
+			return status.Errorf(codes.NotFound, "评价不存在")
+		}
+		if review.Status == newStatus {
+			return nil
+		}
+		oldStatus := review.Status
+		if result := tx.Model(&review).Update("status", newStatus); result.Error != nil {
+			return status.Errorf(codes.Internal, "审核评价失败")
+		}
+		switch {
+		case oldStatus == model.ReviewApproved:
+			changed = true
+			return updateRating(tx, review.Goods, review.Rating, -1)
+		case newStatus == model.ReviewApproved:
+			changed = true
+			return updateRating(tx, review.Goods, review.Rating, 1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		invalidateGoods(review.Goods)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// HelpfulReview 标记评价有用，重复标记不报错也不重复计数
+func (s *GoodsServer) HelpfulReview(ctx context.Context, req *proto.ReviewHelpfulRequest) (*emptypb.Empty, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户不存在")
+	}
+	if req.Id <= 0 {
+		return nil, status.Errorf(codes.NotFound, "评价不存在")
+	}
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		// 只能标记审核通过的评价
+		var review model.GoodsReview
+		if result := tx.Where("id = ? and status = ?", req.Id, model.ReviewApproved).First(&review); result.RowsAffected == 0 {
+			return status.Errorf(codes.NotFound, "评价不存在")
+		}
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.ReviewHelpful{User: req.UserId, Review: req.Id})
+		if result.Error != nil {
+			return status.Errorf(codes.Internal, "标记失败")
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		if result := tx.Model(&model.GoodsReview{}).Where("id = ?", req.Id).
+			Update("helpful_num", gorm.Expr("helpful_num + 1")); result.Error != nil {
+			return status.Errorf(codes.Internal, "标记失败")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
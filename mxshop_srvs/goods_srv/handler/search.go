@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"go.uber.org/zap"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/model"
+	"mxshop_srvs/goods_srv/search"
+)
+
+func goodsDoc(goods model.Goods) search.Doc {
+	return search.Doc{
+		Id:       goods.ID,
+		Name:     goods.Name,
+		Brief:    goods.GoodsBrief,
+		Brand:    goods.Brands.Name,
+		Category: goods.Category.Name,
+	}
+}
+
+// SearchDocs 加载所有商品，用来全量重建索引
+func SearchDocs() ([]search.Doc, error) {
+	var goods []model.Goods
+	if result := global.DB.Preload("Category").Preload("Brands").Find(&goods); result.Error != nil {
+		return nil, result.Error
+	}
+	docs := make([]search.Doc, 0, len(goods))
+	for _, good := range goods {
+		docs = append(docs, goodsDoc(good))
+	}
+	return docs, nil
+}
+
+// indexGoods 重新索引符合条件的商品，商品、品牌、分类修改之后调用
+// 索引失败只记录日志，定时全量重建时会修正
+func indexGoods(query interface{}, args ...interface{}) {
+	if global.SearchIndex == nil {
+		return
+	}
+	var goods []model.Goods
+	if result := global.DB.Preload("Category").Preload("Brands").Where(query, args...).Find(&goods); result.Error != nil {
+		zap.S().Errorf("[search] 更新商品索引失败: %s", result.Error.Error())
+		return
+	}
+	for _, good := range goods {
+		global.SearchIndex.Add(goodsDoc(good))
+	}
+}
@@ -0,0 +1,377 @@
+package handler
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/model"
+	"mxshop_srvs/goods_srv/proto"
+)
+
+func SpecTemplateToResponse(template model.SpecTemplate) *proto.SpecTemplateInfo {
+	return &proto.SpecTemplateInfo{
+		Id:         template.ID,
+		CategoryId: template.CategoryID,
+		Name:       template.Name,
+		Values:     template.Values,
+		Sort:       template.Sort,
+	}
+}
+
+func SkuToResponse(sku model.GoodsSku) *proto.SkuInfo {
+	rsp := &proto.SkuInfo{
+		Id:           sku.ID,
+		GoodsId:      sku.GoodsID,
+		SkuSn:        sku.SkuSn,
+		MarketPrice:  sku.MarketPrice,
+		ShopPrice:    sku.ShopPrice,
+		Image:        sku.Image,
+		OnSale:       sku.OnSale,
+		InventoryKey: sku.InventoryKey(),
+	}
+	for _, spec := range sku.Specs {
+		rsp.Specs = append(rsp.Specs, &proto.SkuSpec{Name: spec.Name, Value: spec.Value})
+	}
+	return rsp
+}
+
+// SpecTemplateList 获取分类的规格模板
+func (s *GoodsServer) SpecTemplateList(ctx context.Context, req *proto.SpecTemplateFilter) (*proto.SpecTemplateListResponse, error) {
+	templates, err := specTemplates(req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &proto.SpecTemplateListResponse{Total: int32(len(templates))}
+	for _, template := range templates {
+		rsp.Data = append(rsp.Data, SpecTemplateToResponse(template))
+	}
+	return rsp, nil
+}
+
+// CreateSpecTemplate 新建规格模板，分类下已经有SKU时不能新增规格，否则已有的SKU缺少这个规格
+func (s *GoodsServer) CreateSpecTemplate(ctx context.Context, req *proto.SpecTemplateInfo) (*proto.SpecTemplateInfo, error) {
+	if err := checkSpecTemplate(req); err != nil {
+		return nil, err
+	}
+	if result := global.DB.First(&model.Category{}, req.CategoryId); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "商品分类不存在")
+	}
+	if result := global.DB.Where(&model.SpecTemplate{CategoryID: req.CategoryId, Name: req.Name}).First(&model.SpecTemplate{}); result.RowsAffected == 1 {
+		return nil, status.Errorf(codes.AlreadyExists, "规格已经存在")
+	}
+	skus, err := categorySkus(req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	if len(skus) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "分类下已经有SKU，不能新增规格")
+	}
+
+	template := model.SpecTemplate{
+		CategoryID: req.CategoryId,
+		Name:       req.Name,
+		Values:     req.Values,
+		Sort:       req.Sort,
+	}
+	if result := global.DB.Save(&template); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "新建规格失败")
+	}
+	return SpecTemplateToResponse(template), nil
+}
+
+// UpdateSpecTemplate 修改规格模板，已经被SKU使用的规格不能改名，使用中的值不能删除
+func (s *GoodsServer) UpdateSpecTemplate(ctx context.Context, req *proto.SpecTemplateInfo) (*emptypb.Empty, error) {
+	var template model.SpecTemplate
+	if result := global.DB.First(&template, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "规格不存在")
+	}
+	req.CategoryId = template.CategoryID
+	if err := checkSpecTemplate(req); err != nil {
+		return nil, err
+	}
+
+	skus, err := categorySkus(template.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]bool, len(req.Values))
+	for _, value := range req.Values {
+		values[value] = true
+	}
+	for _, sku := range skus {
+		for _, spec := range sku.Specs {
+			if spec.Name != template.Name {
+				continue
+			}
+			if req.Name != template.Name {
+				return nil, status.Errorf(codes.FailedPrecondition, "规格已经被SKU使用，不能修改名称")
+			}
+			if !values[spec.Value] {
+				return nil, status.Errorf(codes.FailedPrecondition, "规格值 %s 已经被SKU使用，不能删除", spec.Value)
+			}
+		}
+	}
+
+	template.Name = req.Name
+	template.Values = req.Values
+	template.Sort = req.Sort
+	if result := global.DB.Save(&template); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "修改规格失败")
+	}
+	// 规格的顺序和值在商品详情中返回
+	invalidateGoodsOf(&model.Goods{CategoryID: template.CategoryID})
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteSpecTemplate 删除规格模板，分类下已经有SKU时不能删除
+func (s *GoodsServer) DeleteSpecTemplate(ctx context.Context, req *proto.SpecTemplateInfo) (*emptypb.Empty, error) {
+	var template model.SpecTemplate
+	if result := global.DB.First(&template, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "规格不存在")
+	}
+	skus, err := categorySkus(template.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	if len(skus) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "分类下已经有SKU，不能删除规格")
+	}
+	global.DB.Delete(&template)
+	return &emptypb.Empty{}, nil
+}
+
+// SkuList 获取商品的所有SKU
+func (s *GoodsServer) SkuList(ctx context.Context, req *proto.GoodInfoRequest) (*proto.SkuListResponse, error) {
+	var skus []model.GoodsSku
+	if result := global.DB.Where(&model.GoodsSku{GoodsID: req.Id}).Order("id").Find(&skus); result.Error != nil {
+		return nil, result.Error
+	}
+	return skuListResponse(skus), nil
+}
+
+// BatchGetSku 下单时批量获取SKU的价格和库存的商品id
+func (s *GoodsServer) BatchGetSku(ctx context.Context, req *proto.BatchSkuIdInfo) (*proto.SkuListResponse, error) {
+	var skus []model.GoodsSku
+	if len(req.Id) > 0 {
+		if result := global.DB.Where("id in ?", req.Id).Find(&skus); result.Error != nil {
+			return nil, result.Error
+		}
+	}
+	return skuListResponse(skus), nil
+}
+
+// CreateSku 新建SKU，规格必须和商品分类的规格模板一致
+func (s *GoodsServer) CreateSku(ctx context.Context, req *proto.SkuInfo) (*proto.SkuInfo, error) {
+	var goods model.Goods
+	if result := global.DB.First(&goods, req.GoodsId); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "商品不存在")
+	}
+	specs, err := buildSpecs(goods.CategoryID, req.Specs)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkSkuUnique(goods.ID, specs.Key(), 0); err != nil {
+		return nil, err
+	}
+
+	sku := model.GoodsSku{
+		GoodsID:     goods.ID,
+		SpecKey:     specs.Key(),
+		Specs:       specs,
+		SkuSn:       req.SkuSn,
+		MarketPrice: req.MarketPrice,
+		ShopPrice:   req.ShopPrice,
+		Image:       req.Image,
+		OnSale:      req.OnSale,
+	}
+	if result := global.DB.Save(&sku); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "新建SKU失败")
+	}
+	invalidateGoods(goods.ID)
+	return SkuToResponse(sku), nil
+}
+
+// UpdateSku 修改SKU
+func (s *GoodsServer) UpdateSku(ctx context.Context, req *proto.SkuInfo) (*emptypb.Empty, error) {
+	var sku model.GoodsSku
+	if result := global.DB.First(&sku, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "SKU不存在")
+	}
+	var goods model.Goods
+	if result := global.DB.First(&goods, sku.GoodsID); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "商品不存在")
+	}
+	specs, err := buildSpecs(goods.CategoryID, req.Specs)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkSkuUnique(goods.ID, specs.Key(), sku.ID); err != nil {
+		return nil, err
+	}
+
+	sku.SpecKey = specs.Key()
+	sku.Specs = specs
+	sku.SkuSn = req.SkuSn
+	sku.MarketPrice = req.MarketPrice
+	sku.ShopPrice = req.ShopPrice
+	sku.Image = req.Image
+	sku.OnSale = req.OnSale
+	if result := global.DB.Save(&sku); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "修改SKU失败")
+	}
+	invalidateGoods(goods.ID)
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteSku 删除SKU，直接删除记录，删除之后可以重新添加同样的规格组合
+// 订单中保存了规格的快照，不需要保留删除的SKU
+func (s *GoodsServer) DeleteSku(ctx context.Context, req *proto.SkuInfo) (*emptypb.Empty, error) {
+	var sku model.GoodsSku
+	if result := global.DB.First(&sku, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "SKU不存在")
+	}
+	if result := global.DB.Unscoped().Delete(&sku); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "SKU不存在")
+	}
+	invalidateGoods(sku.GoodsID)
+	// SKU已经删除，删除库存失败只记录日志，之后手动清理
+	if global.InventorySrvClient != nil {
+		if _, err := global.InventorySrvClient.DeleteInv(ctx, &proto.DeleteInvInfo{GoodsIds: []int32{sku.InventoryKey()}}); err != nil {
+			zap.S().Errorw("[DeleteSku] 删除 【库存】失败", "goods", sku.InventoryKey(), "msg", err.Error())
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// specMatrix 商品详情的规格矩阵，只返回SKU用到的规格值，顺序和规格模板一致
+func specMatrix(goods model.Goods) ([]*proto.SpecDimension, []*proto.SkuInfo, error) {
+	var skus []model.GoodsSku
+	if result := global.DB.Where(&model.GoodsSku{GoodsID: goods.ID}).Order("id").Find(&skus); result.Error != nil {
+		return nil, nil, result.Error
+	}
+	if len(skus) == 0 {
+		return nil, nil, nil
+	}
+	templates, err := specTemplates(goods.CategoryID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	used := make(map[string]bool)
+	for _, sku := range skus {
+		for _, spec := range sku.Specs {
+			used[spec.Name+":"+spec.Value] = true
+		}
+	}
+	var dims []*proto.SpecDimension
+	for _, template := range templates {
+		dim := &proto.SpecDimension{Name: template.Name}
+		for _, value := range template.Values {
+			if used[template.Name+":"+value] {
+				dim.Values = append(dim.Values, value)
+			}
+		}
+		dims = append(dims, dim)
+	}
+	return dims, skuListResponse(skus).Data, nil
+}
+
+func specTemplates(categoryId int32) ([]model.SpecTemplate, error) {
+	var templates []model.SpecTemplate
+	if result := global.DB.Where(&model.SpecTemplate{CategoryID: categoryId}).Order("sort, id").Find(&templates); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询规格失败")
+	}
+	return templates, nil
+}
+
+// categorySkus 分类下所有商品的SKU
+func categorySkus(categoryId int32) ([]model.GoodsSku, error) {
+	var skus []model.GoodsSku
+	goodsIds := global.DB.Model(&model.Goods{}).Select("id").Where("category_id = ?", categoryId)
+	if result := global.DB.Where("goods_id in (?)", goodsIds).Find(&skus); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询SKU失败")
+	}
+	return skus, nil
+}
+
+func checkSpecTemplate(req *proto.SpecTemplateInfo) error {
+	if req.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "规格名称不能为空")
+	}
+	if len(req.Values) == 0 {
+		return status.Errorf(codes.InvalidArgument, "规格值不能为空")
+	}
+	seen := make(map[string]bool, len(req.Values))
+	for _, value := range req.Values {
+		if value == "" || seen[value] {
+			return status.Errorf(codes.InvalidArgument, "规格值不能为空或者重复")
+		}
+		seen[value] = true
+	}
+	return nil
+}
+
+// buildSpecs 校验SKU的规格，分类的每个规格模板都要有一个模板中的值，按照模板的顺序返回
+func buildSpecs(categoryId int32, specs []*proto.SkuSpec) (model.SpecList, error) {
+	templates, err := specTemplates(categoryId)
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "商品分类没有规格模板")
+	}
+
+	values := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		if _, ok := values[spec.Name]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "规格 %s 重复", spec.Name)
+		}
+		values[spec.Name] = spec.Value
+	}
+	if len(values) != len(templates) {
+		return nil, status.Errorf(codes.InvalidArgument, "规格和分类的规格模板不一致")
+	}
+
+	list := make(model.SpecList, 0, len(templates))
+	for _, template := range templates {
+		value, ok := values[template.Name]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "缺少规格 %s", template.Name)
+		}
+		valid := false
+		for _, v := range template.Values {
+			if v == value {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, status.Errorf(codes.InvalidArgument, "规格 %s 没有可选值 %s", template.Name, value)
+		}
+		list = append(list, model.SkuSpec{Name: template.Name, Value: value})
+	}
+	return list, nil
+}
+
+// checkSkuUnique 同一个商品的规格组合不能重复，修改时排除自己
+func checkSkuUnique(goodsId int32, specKey string, skuId int32) error {
+	var sku model.GoodsSku
+	result := global.DB.Where(&model.GoodsSku{GoodsID: goodsId, SpecKey: specKey}).First(&sku)
+	if result.RowsAffected == 1 && sku.ID != skuId {
+		return status.Errorf(codes.AlreadyExists, "规格组合已经存在")
+	}
+	return nil
+}
+
+func skuListResponse(skus []model.GoodsSku) *proto.SkuListResponse {
+	rsp := &proto.SkuListResponse{Total: int32(len(skus))}
+	for _, sku := range skus {
+		rsp.Data = append(rsp.Data, SkuToResponse(sku))
+	}
+	return rsp
+}
@@ -0,0 +1,56 @@
+package initialize
+
+import (
+	"fmt"
+	"time"
+
+	goredislib "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"mxshop_srvs/goods_srv/cache"
+	"mxshop_srvs/goods_srv/global"
+)
+
+// InitCache 配置了redis时使用本地LRU加redis的两级缓存，否则只使用进程内的缓存
+func InitCache() {
+	c := global.ServerConfig.CacheInfo
+	ttl := seconds(c.TTL, 300)
+	notFoundTTL := seconds(c.NotFoundTTL, 30)
+	localTTL := seconds(c.LocalTTL, 10)
+	localSize := c.LocalSize
+	if localSize <= 0 {
+		localSize = 10000
+	}
+
+	var store cache.Store
+	if r := global.ServerConfig.RedisInfo; r.Host != "" {
+		client := goredislib.NewClient(&goredislib.Options{
+			Addr: fmt.Sprintf("%s:%d", r.Host, r.Port),
+		})
+		store = cache.NewTiered(cache.NewLRU(localSize), cache.NewRedis(client), localTTL)
+		zap.S().Infof("商品缓存使用redis %s:%d", r.Host, r.Port)
+	} else {
+		store = cache.NewLRU(localSize)
+		zap.S().Info("没有配置redis，商品缓存只使用进程内的缓存")
+	}
+	global.Cache = cache.New(store, ttl, notFoundTTL)
+
+	if c.MetricsInterval <= 0 {
+		return
+	}
+	go func() {
+		for range time.Tick(time.Duration(c.MetricsInterval) * time.Second) {
+			for name, counter := range global.Cache.Metrics() {
+				zap.S().Infof("[Cache] %s 命中%d 未命中%d 命中空值%d 读数据库%d 失败%d 命中率%.2f%%",
+					name, counter.Hits, counter.Misses, counter.NotFound, counter.Loads, counter.Errors, counter.HitRate()*100)
+			}
+		}
+	}()
+}
+
+func seconds(value, defaultValue int) time.Duration {
+	if value <= 0 {
+		value = defaultValue
+	}
+	return time.Duration(value) * time.Second
+}
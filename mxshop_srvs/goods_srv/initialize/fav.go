@@ -0,0 +1,30 @@
+package initialize
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/handler"
+)
+
+// InitFavReconcile 配置了间隔时定时按照收藏记录修正商品的收藏数
+func InitFavReconcile() {
+	interval := global.ServerConfig.FavReconcile
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		for range time.Tick(time.Duration(interval) * time.Second) {
+			rsp, err := handler.ReconcileAllFavNum()
+			if err != nil {
+				zap.S().Errorf("修正商品收藏数失败: %s", err.Error())
+				continue
+			}
+			if rsp.Fixed > 0 {
+				zap.S().Warnf("检查了%d件商品，修正了%d件商品的收藏数", rsp.Checked, rsp.Fixed)
+			}
+		}
+	}()
+}
@@ -0,0 +1,38 @@
+package initialize
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/handler"
+	"mxshop_srvs/goods_srv/search"
+)
+
+// InitSearch 启动时全量建立商品的全文索引，配置了重建间隔时定时重建
+// 每个实例只能增量更新自己处理的修改，定时重建让多个实例的索引保持一致
+func InitSearch() {
+	global.SearchIndex = search.NewIndex()
+	rebuildSearch()
+
+	interval := global.ServerConfig.SearchRebuild
+	if interval <= 0 {
+		return
+	}
+	go func() {
+		for range time.Tick(time.Duration(interval) * time.Second) {
+			rebuildSearch()
+		}
+	}()
+}
+
+func rebuildSearch() {
+	docs, err := handler.SearchDocs()
+	if err != nil {
+		zap.S().Errorf("建立商品索引失败: %s", err.Error())
+		return
+	}
+	global.SearchIndex.Rebuild(docs)
+	zap.S().Infof("商品索引建立完成，共%d件商品", global.SearchIndex.Len())
+}
@@ -0,0 +1,40 @@
+package initialize
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"mxshop_srvs/goods_srv/global"
+	"mxshop_srvs/goods_srv/proto"
+)
+
+func InitSrvConn() {
+	consulInfo := global.ServerConfig.ConsulInfo
+
+	//初始化订单服务连接，评价商品时检查购买记录
+	orderConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.OrderSrvInfo.Name),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【订单服务失败】")
+	}
+
+	global.OrderSrvClient = proto.NewOrderClient(orderConn)
+
+	//初始化库存服务连接，删除商品之后删除库存
+	invConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.InventorySrvInfo.Name),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【库存服务失败】")
+	}
+
+	global.InventorySrvClient = proto.NewInventoryClient(invConn)
+}
@@ -29,6 +29,10 @@ func main() {
 	initialize.InitLogger()
 	initialize.InitConfig()
 	initialize.InitDB()
+	initialize.InitCache()
+	initialize.InitSearch()
+	initialize.InitFavReconcile()
+	initialize.InitSrvConn()
 
 	flag.Parse()
 	if *Port == 0 {
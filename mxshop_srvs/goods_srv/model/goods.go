@@ -66,10 +66,21 @@ type Goods struct {
 	ClickNum        int32    `gorm:"type:int;default:0;not null;comment:'点击数'"`
 	SoldNum         int32    `gorm:"type:int;default:0;not null;comment:'销售量'"`
 	FavNum          int32    `gorm:"type:int;default:0;not null;comment:'收藏数'"`
+	ReviewNum       int32    `gorm:"type:int;default:0;not null;comment:'审核通过的评价数'"`
+	RatingSum       int32    `gorm:"type:int;default:0;not null;comment:'审核通过的评价的星级之和'"`
 	MarketPrice     float32  `gorm:"not null;comment:'商品价格'"`
 	ShopPrice       float32  `gorm:"not null;comment:'实际价格'"`
 	GoodsBrief      string   `gorm:"type:varchar(100);not null;comment:'商品简介'"`
-	Images          GormList `gorm:"type:varchar(1000);not null;comment:'商品图片'"`
-	DescImages      GormList `gorm:"type:varchar(1000);not null;comment:'商品图片'"`
+	Images          GormList `gorm:"type:varchar(4000);not null;comment:'商品图片'"`
+	DescImages      GormList `gorm:"type:varchar(4000);not null;comment:'商品详情图片'"`
 	GoodsFrontImage string   `gorm:"type:varchar(200);not null;comment:'商品展示图'"`
+	GoodsDesc       string   `gorm:"type:text;not null;comment:'商品详情，过滤之后的html'"`
+}
+
+// UserFav 用户收藏的商品，同一个用户同一个商品只有一条记录
+// 取消收藏时直接删除记录，不使用逻辑删除，否则再次收藏会违反唯一索引
+type UserFav struct {
+	BaseModel
+	User  int32 `gorm:"type:int;not null;uniqueIndex:idx_user_goods,priority:1"`
+	Goods int32 `gorm:"type:int;not null;uniqueIndex:idx_user_goods,priority:2;index"`
 }
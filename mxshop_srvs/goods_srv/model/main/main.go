@@ -35,6 +35,8 @@ func main() {
 	}
 	
 	_ = db.AutoMigrate(&model.Category{},
-		&model.Brands{}, &model.GoodsCategoryBrand{}, &model.Banner{}, &model.Goods{})
+		&model.Brands{}, &model.GoodsCategoryBrand{}, &model.Banner{}, &model.Goods{},
+		&model.SpecTemplate{}, &model.GoodsSku{}, &model.UserFav{},
+		&model.GoodsReview{}, &model.ReviewHelpful{})
 }
 
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// 评价的审核状态，和proto中的ReviewStatus一致
+const (
+	ReviewPending  int32 = 1
+	ReviewApproved int32 = 2
+	ReviewRejected int32 = 3
+)
+
+// GoodsReview 商品评价，订单中的每件商品只能评价一次
+// 审核通过的评价计入商品的ReviewNum和RatingSum
+type GoodsReview struct {
+	BaseModel
+	User       int32    `gorm:"type:int;not null;index"`
+	Goods      int32    `gorm:"type:int;not null;index:idx_goods_status,priority:1"`
+	Status     int32    `gorm:"type:tinyint;not null;default:1;index:idx_goods_status,priority:2;comment:'1(待审核) 2(审核通过) 3(审核不通过)'"`
+	Order      int32    `gorm:"type:int;not null"`
+	OrderGoods int32    `gorm:"type:int;not null;uniqueIndex"`
+	SkuSpecs   string   `gorm:"type:varchar(200);not null;default:'';comment:'购买时的规格'"`
+	Rating     int32    `gorm:"type:tinyint;not null;comment:'1-5星'"`
+	Content    string   `gorm:"type:varchar(500);not null"`
+	Images     GormList `gorm:"type:varchar(2000);not null"`
+	HelpfulNum int32    `gorm:"type:int;not null;default:0;comment:'有用数'"`
+	Reply      string   `gorm:"type:varchar(500);not null;default:'';comment:'商家的回复'"`
+	ReplyTime  *time.Time
+}
+
+// ReviewHelpful 用户标记有用的评价，同一个用户同一条评价只有一条记录
+type ReviewHelpful struct {
+	BaseModel
+	User   int32 `gorm:"type:int;not null;uniqueIndex:idx_user_review,priority:1"`
+	Review int32 `gorm:"type:int;not null;uniqueIndex:idx_user_review,priority:2"`
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"strings"
+)
+
+// SpecTemplate 分类的规格模板，同一个分类的商品使用相同的规格，比如衣服的颜色和尺码
+type SpecTemplate struct {
+	BaseModel
+	CategoryID int32    `gorm:"type:int;index;not null"`
+	Name       string   `gorm:"type:varchar(20);not null;comment:'规格名称'"`
+	Values     GormList `gorm:"type:varchar(1000);not null;comment:'可选的规格值'"`
+	Sort       int32    `gorm:"type:int;default:0;not null;comment:'越小越靠前'"`
+}
+
+func (SpecTemplate) TableName() string {
+	return "spectemplate"
+}
+
+// SkuSpec SKU一种规格的值
+type SkuSpec struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// SpecList SKU的规格，按照规格模板的顺序保存
+type SpecList []SkuSpec
+
+func (s SpecList) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+func (s *SpecList) Scan(value interface{}) error {
+	return json.Unmarshal(value.([]byte), &s)
+}
+
+// Key 规格组合的唯一标识，同一个商品的规格组合不能重复
+func (s SpecList) Key() string {
+	parts := make([]string, 0, len(s))
+	for _, spec := range s {
+		parts = append(parts, spec.Name+":"+spec.Value)
+	}
+	return strings.Join(parts, ";")
+}
+
+// SkuInventoryBase SKU在库存服务中的商品id从这里开始，和没有规格的商品id区分开
+const SkuInventoryBase = 1000000000
+
+// GoodsSku 商品的SKU，每种规格组合一条，有自己的价格、图片和库存
+type GoodsSku struct {
+	BaseModel
+	GoodsID     int32    `gorm:"type:int;not null;index:idx_goods_spec,unique"`
+	SpecKey     string   `gorm:"type:varchar(200);not null;index:idx_goods_spec,unique;comment:'规格组合'"`
+	Specs       SpecList `gorm:"type:varchar(1000);not null"`
+	SkuSn       string   `gorm:"type:varchar(50);not null;comment:'商家的内部编号'"`
+	MarketPrice float32  `gorm:"not null"`
+	ShopPrice   float32  `gorm:"not null"`
+	Image       string   `gorm:"type:varchar(200);not null;default:''"`
+	OnSale      bool     `gorm:"default:false;not null"`
+}
+
+func (GoodsSku) TableName() string {
+	return "goodssku"
+}
+
+// InventoryKey SKU在库存服务中的商品id
+func (s GoodsSku) InventoryKey() int32 {
+	return SkuInventoryBase + s.ID
+}
@@ -0,0 +1,470 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v4.25.6
+// source: inventory.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Inventory_SetInv_FullMethodName          = "/Inventory/SetInv"
+	Inventory_InvDetail_FullMethodName       = "/Inventory/InvDetail"
+	Inventory_Sell_FullMethodName            = "/Inventory/Sell"
+	Inventory_Reback_FullMethodName          = "/Inventory/Reback"
+	Inventory_DeleteInv_FullMethodName       = "/Inventory/DeleteInv"
+	Inventory_WarehouseList_FullMethodName   = "/Inventory/WarehouseList"
+	Inventory_CreateWarehouse_FullMethodName = "/Inventory/CreateWarehouse"
+	Inventory_UpdateWarehouse_FullMethodName = "/Inventory/UpdateWarehouse"
+	Inventory_SetSafeStocks_FullMethodName   = "/Inventory/SetSafeStocks"
+	Inventory_LowStockList_FullMethodName    = "/Inventory/LowStockList"
+)
+
+// InventoryClient is the client API for Inventory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InventoryClient interface {
+	SetInv(ctx context.Context, in *GoodsInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	InvDetail(ctx context.Context, in *GoodsInvInfo, opts ...grpc.CallOption) (*GoodsInvInfo, error)
+	// 购买的时候，有可能是从购物车购买的，这就可能涉及到多件商品的购买库存；这里还涉及到了分布式事务
+	Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*SellInfo, error)
+	Reback(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteInv(ctx context.Context, in *DeleteInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 仓库
+	WarehouseList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WarehouseListResponse, error)
+	CreateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*WarehouseInfo, error)
+	UpdateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 安全库存
+	SetSafeStocks(ctx context.Context, in *SafeStocksInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LowStockList(ctx context.Context, in *LowStockRequest, opts ...grpc.CallOption) (*LowStockListResponse, error)
+}
+
+type inventoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInventoryClient(cc grpc.ClientConnInterface) InventoryClient {
+	return &inventoryClient{cc}
+}
+
+func (c *inventoryClient) SetInv(ctx context.Context, in *GoodsInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Inventory_SetInv_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) InvDetail(ctx context.Context, in *GoodsInvInfo, opts ...grpc.CallOption) (*GoodsInvInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoodsInvInfo)
+	err := c.cc.Invoke(ctx, Inventory_InvDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*SellInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SellInfo)
+	err := c.cc.Invoke(ctx, Inventory_Sell_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) Reback(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Inventory_Reback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) DeleteInv(ctx context.Context, in *DeleteInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Inventory_DeleteInv_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) WarehouseList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WarehouseListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarehouseListResponse)
+	err := c.cc.Invoke(ctx, Inventory_WarehouseList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) CreateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*WarehouseInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarehouseInfo)
+	err := c.cc.Invoke(ctx, Inventory_CreateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) UpdateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Inventory_UpdateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) SetSafeStocks(ctx context.Context, in *SafeStocksInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Inventory_SetSafeStocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) LowStockList(ctx context.Context, in *LowStockRequest, opts ...grpc.CallOption) (*LowStockListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LowStockListResponse)
+	err := c.cc.Invoke(ctx, Inventory_LowStockList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryServer is the server API for Inventory service.
+// All implementations must embed UnimplementedInventoryServer
+// for forward compatibility.
+type InventoryServer interface {
+	SetInv(context.Context, *GoodsInvInfo) (*emptypb.Empty, error)
+	InvDetail(context.Context, *GoodsInvInfo) (*GoodsInvInfo, error)
+	// 购买的时候，有可能是从购物车购买的，这就可能涉及到多件商品的购买库存；这里还涉及到了分布式事务
+	Sell(context.Context, *SellInfo) (*SellInfo, error)
+	Reback(context.Context, *SellInfo) (*emptypb.Empty, error)
+	DeleteInv(context.Context, *DeleteInvInfo) (*emptypb.Empty, error)
+	// 仓库
+	WarehouseList(context.Context, *emptypb.Empty) (*WarehouseListResponse, error)
+	CreateWarehouse(context.Context, *WarehouseInfo) (*WarehouseInfo, error)
+	UpdateWarehouse(context.Context, *WarehouseInfo) (*emptypb.Empty, error)
+	// 安全库存
+	SetSafeStocks(context.Context, *SafeStocksInfo) (*emptypb.Empty, error)
+	LowStockList(context.Context, *LowStockRequest) (*LowStockListResponse, error)
+	mustEmbedUnimplementedInventoryServer()
+}
+
+// UnimplementedInventoryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryServer struct{}
+
+func (UnimplementedInventoryServer) SetInv(context.Context, *GoodsInvInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInv not implemented")
+}
+func (UnimplementedInventoryServer) InvDetail(context.Context, *GoodsInvInfo) (*GoodsInvInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvDetail not implemented")
+}
+func (UnimplementedInventoryServer) Sell(context.Context, *SellInfo) (*SellInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sell not implemented")
+}
+func (UnimplementedInventoryServer) Reback(context.Context, *SellInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reback not implemented")
+}
+func (UnimplementedInventoryServer) DeleteInv(context.Context, *DeleteInvInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInv not implemented")
+}
+func (UnimplementedInventoryServer) WarehouseList(context.Context, *emptypb.Empty) (*WarehouseListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarehouseList not implemented")
+}
+func (UnimplementedInventoryServer) CreateWarehouse(context.Context, *WarehouseInfo) (*WarehouseInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedInventoryServer) UpdateWarehouse(context.Context, *WarehouseInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWarehouse not implemented")
+}
+func (UnimplementedInventoryServer) SetSafeStocks(context.Context, *SafeStocksInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSafeStocks not implemented")
+}
+func (UnimplementedInventoryServer) LowStockList(context.Context, *LowStockRequest) (*LowStockListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LowStockList not implemented")
+}
+func (UnimplementedInventoryServer) mustEmbedUnimplementedInventoryServer() {}
+func (UnimplementedInventoryServer) testEmbeddedByValue()                   {}
+
+// UnsafeInventoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServer will
+// result in compilation errors.
+type UnsafeInventoryServer interface {
+	mustEmbedUnimplementedInventoryServer()
+}
+
+func RegisterInventoryServer(s grpc.ServiceRegistrar, srv InventoryServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Inventory_ServiceDesc, srv)
+}
+
+func _Inventory_SetInv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsInvInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).SetInv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_SetInv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).SetInv(ctx, req.(*GoodsInvInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_InvDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GoodsInvInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).InvDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_InvDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).InvDetail(ctx, req.(*GoodsInvInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_Sell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).Sell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_Sell_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).Sell(ctx, req.(*SellInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_Reback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).Reback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_Reback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).Reback(ctx, req.(*SellInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_DeleteInv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInvInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).DeleteInv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_DeleteInv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).DeleteInv(ctx, req.(*DeleteInvInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_WarehouseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).WarehouseList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_WarehouseList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).WarehouseList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarehouseInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_CreateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).CreateWarehouse(ctx, req.(*WarehouseInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_UpdateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarehouseInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).UpdateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_UpdateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).UpdateWarehouse(ctx, req.(*WarehouseInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_SetSafeStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SafeStocksInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).SetSafeStocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_SetSafeStocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).SetSafeStocks(ctx, req.(*SafeStocksInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_LowStockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).LowStockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_LowStockList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).LowStockList(ctx, req.(*LowStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Inventory_ServiceDesc is the grpc.ServiceDesc for Inventory service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Inventory_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "Inventory",
+	HandlerType: (*InventoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetInv",
+			Handler:    _Inventory_SetInv_Handler,
+		},
+		{
+			MethodName: "InvDetail",
+			Handler:    _Inventory_InvDetail_Handler,
+		},
+		{
+			MethodName: "Sell",
+			Handler:    _Inventory_Sell_Handler,
+		},
+		{
+			MethodName: "Reback",
+			Handler:    _Inventory_Reback_Handler,
+		},
+		{
+			MethodName: "DeleteInv",
+			Handler:    _Inventory_DeleteInv_Handler,
+		},
+		{
+			MethodName: "WarehouseList",
+			Handler:    _Inventory_WarehouseList_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _Inventory_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "UpdateWarehouse",
+			Handler:    _Inventory_UpdateWarehouse_Handler,
+		},
+		{
+			MethodName: "SetSafeStocks",
+			Handler:    _Inventory_SetSafeStocks_Handler,
+		},
+		{
+			MethodName: "LowStockList",
+			Handler:    _Inventory_LowStockList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inventory.proto",
+}
@@ -0,0 +1,209 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+/*
+	进程内的商品全文索引，倒排表保存在内存中
+	商品的名称、简介、品牌和分类名称都参与搜索，不同字段的权重不同，按照BM25计算相关度
+	新建、修改、删除商品时增量更新，每个服务实例各自保存一份，定时全量重建保证多个实例最终一致
+*/
+
+// 字段的权重，名称中出现的词比简介中出现的词更相关
+const (
+	weightName     = 3
+	weightBrand    = 2
+	weightCategory = 2
+	weightBrief    = 1
+)
+
+// BM25的参数
+const (
+	k1 = 1.2
+	b  = 0.75
+)
+
+// Doc 参与搜索的商品信息
+type Doc struct {
+	Id       int32
+	Name     string
+	Brief    string
+	Brand    string
+	Category string
+}
+
+// Result 搜索结果，按照分数从高到低排序
+type Result struct {
+	Id    int32
+	Score float64
+}
+
+type Index struct {
+	mu       sync.RWMutex
+	postings map[string]map[int32]float64 // 词 -> 商品 -> 加权的词频
+	terms    map[int32][]string           // 商品包含的词，删除时使用
+	lengths  map[int32]float64            // 商品的加权长度
+	total    float64                      // 所有商品的加权长度之和
+}
+
+func NewIndex() *Index {
+	idx := &Index{}
+	idx.reset()
+	return idx
+}
+
+func (idx *Index) reset() {
+	idx.postings = make(map[string]map[int32]float64)
+	idx.terms = make(map[int32][]string)
+	idx.lengths = make(map[int32]float64)
+	idx.total = 0
+}
+
+// Len 索引中的商品数量
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.terms)
+}
+
+// Rebuild 用全部商品重建索引
+func (idx *Index) Rebuild(docs []Doc) {
+	fresh := NewIndex()
+	for _, doc := range docs {
+		fresh.add(doc)
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.postings, idx.terms, idx.lengths, idx.total = fresh.postings, fresh.terms, fresh.lengths, fresh.total
+}
+
+// Add 添加商品，已经存在的商品会先删除旧的索引
+func (idx *Index) Add(doc Doc) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(doc.Id)
+	idx.add(doc)
+}
+
+// Remove 删除商品
+func (idx *Index) Remove(id int32) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(id)
+}
+
+func (idx *Index) add(doc Doc) {
+	freqs := make(map[string]float64)
+	var length float64
+	for _, field := range []struct {
+		text   string
+		weight float64
+	}{
+		{doc.Name, weightName},
+		{doc.Brand, weightBrand},
+		{doc.Category, weightCategory},
+		{doc.Brief, weightBrief},
+	} {
+		for _, term := range indexTerms(field.text) {
+			freqs[term] += field.weight
+			length += field.weight
+		}
+	}
+	if len(freqs) == 0 {
+		return
+	}
+
+	terms := make([]string, 0, len(freqs))
+	for term, freq := range freqs {
+		docs, ok := idx.postings[term]
+		if !ok {
+			docs = make(map[int32]float64)
+			idx.postings[term] = docs
+		}
+		docs[doc.Id] = freq
+		terms = append(terms, term)
+	}
+	idx.terms[doc.Id] = terms
+	idx.lengths[doc.Id] = length
+	idx.total += length
+}
+
+func (idx *Index) remove(id int32) {
+	terms, ok := idx.terms[id]
+	if !ok {
+		return
+	}
+	for _, term := range terms {
+		docs := idx.postings[term]
+		delete(docs, id)
+		if len(docs) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	idx.total -= idx.lengths[id]
+	delete(idx.terms, id)
+	delete(idx.lengths, id)
+}
+
+// Search 搜索包含所有关键词的商品，按照相关度排序，相关度相同时id小的在前
+func (idx *Index) Search(query string) []Result {
+	required, scoring := queryTerms(query)
+	if len(required) == 0 {
+		return nil
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	// 从最短的倒排表开始求交集
+	lists := make([]map[int32]float64, 0, len(required))
+	for _, term := range required {
+		docs, ok := idx.postings[term]
+		if !ok {
+			return nil
+		}
+		lists = append(lists, docs)
+	}
+	sort.Slice(lists, func(i, j int) bool { return len(lists[i]) < len(lists[j]) })
+
+	n := float64(len(idx.terms))
+	avg := idx.total / n
+	var results []Result
+	for id := range lists[0] {
+		matched := true
+		for _, docs := range lists[1:] {
+			if _, ok := docs[id]; !ok {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+
+		var score float64
+		norm := k1 * (1 - b + b*idx.lengths[id]/avg)
+		for _, term := range scoring {
+			docs := idx.postings[term]
+			tf, ok := docs[id]
+			if !ok {
+				continue
+			}
+			df := float64(len(docs))
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * tf * (k1 + 1) / (tf + norm)
+		}
+		results = append(results, Result{Id: id, Score: score})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Id < results[j].Id
+	})
+	return results
+}
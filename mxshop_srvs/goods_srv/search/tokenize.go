@@ -0,0 +1,102 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+/*
+	分词不依赖词典：
+		中文按字切分，同时生成相邻两个字的二元组，二元组用来给相邻出现的词更高的分数
+		英文和数字按照连续的字母、连续的数字切分，统一转成小写，"iPhone15" 切分为 iphone 和 15
+*/
+
+// runs 把文本切分成连续的中文、字母、数字片段
+func runs(text string) [][]rune {
+	var (
+		result [][]rune
+		cur    []rune
+		kind   int
+	)
+	flush := func() {
+		if len(cur) > 0 {
+			result = append(result, cur)
+			cur = nil
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		k := runeKind(r)
+		if k == 0 || k != kind {
+			flush()
+		}
+		kind = k
+		if k != 0 {
+			cur = append(cur, r)
+		}
+	}
+	flush()
+	return result
+}
+
+const (
+	kindHan = iota + 1
+	kindLetter
+	kindDigit
+)
+
+func runeKind(r rune) int {
+	switch {
+	case unicode.Is(unicode.Han, r):
+		return kindHan
+	case unicode.IsLetter(r):
+		return kindLetter
+	case unicode.IsDigit(r):
+		return kindDigit
+	}
+	return 0
+}
+
+// indexTerms 建索引时的分词，中文生成单字和二元组
+func indexTerms(text string) []string {
+	var terms []string
+	for _, run := range runs(text) {
+		if runeKind(run[0]) != kindHan {
+			terms = append(terms, string(run))
+			continue
+		}
+		for i := range run {
+			terms = append(terms, string(run[i]))
+			if i+1 < len(run) {
+				terms = append(terms, string(run[i:i+2]))
+			}
+		}
+	}
+	return terms
+}
+
+// queryTerms 查询时的分词
+// required是商品必须包含的词：中文的单字和英文数字的词，scoring额外包含中文二元组，只用来计算分数
+func queryTerms(text string) (required, scoring []string) {
+	seen := make(map[string]bool)
+	add := func(list *[]string, term string) {
+		if !seen[term] {
+			seen[term] = true
+			*list = append(*list, term)
+		}
+	}
+	var bigrams []string
+	for _, run := range runs(text) {
+		if runeKind(run[0]) != kindHan {
+			add(&required, string(run))
+			continue
+		}
+		for i := range run {
+			add(&required, string(run[i]))
+			if i+1 < len(run) {
+				add(&bigrams, string(run[i:i+2]))
+			}
+		}
+	}
+	scoring = append(append(scoring, required...), bigrams...)
+	return required, scoring
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"mxshop_srvs/goods_srv/cache"
+)
+
+/*
+	商品缓存的测试，使用进程内的缓存，不依赖redis和数据库
+*/
+
+var ctx = context.Background()
+
+// TestReadThrough 第一次读数据库，之后命中缓存，删除之后重新读取
+func TestReadThrough() {
+	c := cache.New(cache.NewMemory(), time.Minute, time.Second)
+	var loads int32
+	load := func() ([]byte, error) {
+		atomic.AddInt32(&loads, 1)
+		return []byte("goods"), nil
+	}
+	for i := 0; i < 3; i++ {
+		data, err := c.Fetch(ctx, "goods", "goods:1", load)
+		if err != nil || string(data) != "goods" {
+			panic(fmt.Sprintf("读取缓存失败: %q %v", data, err))
+		}
+	}
+	if loads != 1 {
+		panic(fmt.Sprintf("应该只读一次数据库，实际读了%d次", loads))
+	}
+	c.Delete(ctx, "goods:1")
+	if _, _ = c.Fetch(ctx, "goods", "goods:1", load); loads != 2 {
+		panic("删除缓存之后应该重新读取")
+	}
+	counter := c.Metrics()["goods"]
+	if counter.Hits != 2 || counter.Misses != 2 || counter.HitRate() != 0.5 {
+		panic(fmt.Sprintf("命中统计不正确: %+v", counter))
+	}
+}
+
+// TestNotFound 不存在的数据缓存空值，过期之后重新读取
+func TestNotFound() {
+	c := cache.New(cache.NewMemory(), time.Minute, 50*time.Millisecond)
+	var loads int32
+	load := func() ([]byte, error) {
+		atomic.AddInt32(&loads, 1)
+		return nil, cache.ErrNotFound
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := c.Fetch(ctx, "goods", "goods:404", load); !errors.Is(err, cache.ErrNotFound) {
+			panic(fmt.Sprintf("应该返回ErrNotFound，实际返回%v", err))
+		}
+	}
+	if loads != 1 {
+		panic(fmt.Sprintf("空值应该被缓存，实际读了%d次", loads))
+	}
+	time.Sleep(80 * time.Millisecond)
+	c.Fetch(ctx, "goods", "goods:404", load)
+	if loads != 2 {
+		panic("空值过期之后应该重新读取")
+	}
+	if c.Metrics()["goods"].NotFound != 2 {
+		panic("命中空值的统计不正确")
+	}
+
+	// 其他错误不缓存
+	failed := errors.New("数据库不可用")
+	for i := 0; i < 2; i++ {
+		if _, err := c.Fetch(ctx, "goods", "goods:500", func() ([]byte, error) {
+			atomic.AddInt32(&loads, 1)
+			return nil, failed
+		}); err != failed {
+			panic("应该返回load的错误")
+		}
+	}
+	if loads != 4 {
+		panic("读取失败不应该缓存")
+	}
+}
+
+// TestSingleflight 同一个key同时没有命中时只读一次数据库
+func TestSingleflight() {
+	c := cache.New(cache.NewMemory(), time.Minute, time.Second)
+	var loads int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := c.Fetch(ctx, "banner", "banners", func() ([]byte, error) {
+				atomic.AddInt32(&loads, 1)
+				time.Sleep(50 * time.Millisecond)
+				return []byte("banners"), nil
+			})
+			if err != nil || string(data) != "banners" {
+				panic("并发读取缓存失败")
+			}
+		}()
+	}
+	wg.Wait()
+	if loads != 1 {
+		panic(fmt.Sprintf("并发读取应该只读一次数据库，实际读了%d次", loads))
+	}
+}
+
+// TestLRU 超过容量时淘汰最久没有使用的key
+func TestLRU() {
+	l := cache.NewLRU(2)
+	l.Set(ctx, "a", []byte("1"), time.Minute)
+	l.Set(ctx, "b", []byte("2"), time.Minute)
+	l.Get(ctx, "a")
+	l.Set(ctx, "c", []byte("3"), time.Minute)
+	if _, ok, _ := l.Get(ctx, "b"); ok {
+		panic("b应该被淘汰")
+	}
+	if _, ok, _ := l.Get(ctx, "a"); !ok {
+		panic("a最近使用过，不应该被淘汰")
+	}
+	if l.Len() != 2 {
+		panic(fmt.Sprintf("LRU的长度应该是2，实际是%d", l.Len()))
+	}
+	l.Set(ctx, "d", []byte("4"), 20*time.Millisecond)
+	time.Sleep(40 * time.Millisecond)
+	if _, ok, _ := l.Get(ctx, "d"); ok {
+		panic("过期的key不应该命中")
+	}
+}
+
+// TestTiered 本地没有命中时读远程并写入本地，删除时两级都删除
+func TestTiered() {
+	local, remote := cache.NewLRU(10), cache.NewMemory()
+	t := cache.NewTiered(local, remote, time.Minute)
+	remote.Set(ctx, "k", []byte("v"), time.Minute)
+	if v, ok, _ := t.Get(ctx, "k"); !ok || string(v) != "v" {
+		panic("应该从远程缓存读取")
+	}
+	if v, ok, _ := local.Get(ctx, "k"); !ok || string(v) != "v" {
+		panic("远程缓存的内容应该写入本地")
+	}
+	t.Delete(ctx, "k")
+	if _, ok, _ := local.Get(ctx, "k"); ok {
+		panic("本地缓存应该被删除")
+	}
+	if _, ok, _ := remote.Get(ctx, "k"); ok {
+		panic("远程缓存应该被删除")
+	}
+}
+
+func main() {
+	TestReadThrough()
+	TestNotFound()
+	TestSingleflight()
+	TestLRU()
+	TestTiered()
+	fmt.Println("ok")
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"mxshop_srvs/goods_srv/proto"
 )
 
 func TestGetCategoryList() {
@@ -14,3 +17,61 @@ func TestGetCategoryList() {
 	fmt.Println(rsp.Total)
 	fmt.Println(rsp.JsonData)
 }
+
+// TestMoveCategory 不能把分类移动到自己的子分类下，移动之后子分类的级别跟着变化
+func TestMoveCategory() {
+	ctx := context.Background()
+	top, err := brandClient.CreateCategory(ctx, &proto.CategoryInfoRequest{Name: "测试一级", Level: 1})
+	if err != nil {
+		panic(err)
+	}
+	sub, err := brandClient.CreateCategory(ctx, &proto.CategoryInfoRequest{Name: "测试二级", ParentCategory: top.Id})
+	if err != nil {
+		panic(err)
+	}
+	if _, err := brandClient.MoveCategory(ctx, &proto.MoveCategoryRequest{Id: top.Id, ParentCategory: sub.Id}); status.Code(err) != codes.InvalidArgument {
+		panic(fmt.Sprintf("移动到自己的子分类下应该返回InvalidArgument，实际返回%v", err))
+	}
+	// 移动成一级分类之后再移动到另一个一级分类下
+	if _, err := brandClient.MoveCategory(ctx, &proto.MoveCategoryRequest{Id: sub.Id}); err != nil {
+		panic(err)
+	}
+	if _, err := brandClient.MoveCategory(ctx, &proto.MoveCategoryRequest{Id: top.Id, ParentCategory: sub.Id}); err != nil {
+		panic(err)
+	}
+	rsp, err := brandClient.GetSubCategory(ctx, &proto.CategoryListRequest{Id: top.Id})
+	if err != nil {
+		panic(err)
+	}
+	if rsp.Info.Level != 2 || rsp.Info.ParentCategory != sub.Id {
+		panic(fmt.Sprintf("移动之后分类的级别不正确: %v", rsp.Info))
+	}
+	for _, id := range []int32{top.Id, sub.Id} {
+		if _, err := brandClient.DeleteCategory(ctx, &proto.DeleteCategoryRequest{Id: id}); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// TestCategoryBreadcrumbs 分类树中的每个分类的路径都从一级分类开始，以自己结束
+func TestCategoryBreadcrumbs() {
+	ctx := context.Background()
+	rsp, err := brandClient.GetAllCategorysList(ctx, &empty.Empty{})
+	if err != nil {
+		panic(err)
+	}
+	var check func(trees []*proto.CategoryTree, depth int)
+	check = func(trees []*proto.CategoryTree, depth int) {
+		for _, tree := range trees {
+			path, err := brandClient.CategoryBreadcrumbs(ctx, &proto.BreadcrumbsRequest{CategoryId: tree.Id})
+			if err != nil {
+				panic(err)
+			}
+			if len(path.Data) != depth || path.Data[depth-1].Id != tree.Id {
+				panic(fmt.Sprintf("分类%d的路径不正确: %v", tree.Id, path.Data))
+			}
+			check(tree.SubCategorys, depth+1)
+		}
+	}
+	check(rsp.Tree, 1)
+}
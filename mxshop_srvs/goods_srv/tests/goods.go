@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_srvs/goods_srv/proto"
+)
+
+func TestGoodsListFacets() {
+	rsp, err := brandClient.GoodsList(context.Background(), &proto.GoodsFilterRequest{
+		KeyWords:     "手机",
+		WithFacets:   true,
+		PriceBuckets: []int32{1000, 3000},
+	})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(rsp.Total)
+	var sum int32
+	for _, brand := range rsp.Facets.Brands {
+		fmt.Printf("%s(%d)\n", brand.Name, brand.Count)
+		sum += brand.Count
+	}
+	if sum != rsp.Total {
+		panic(fmt.Sprintf("品牌的商品数量之和%d和总数%d不一致", sum, rsp.Total))
+	}
+	for _, price := range rsp.Facets.Prices {
+		fmt.Printf("%d-%d: %d\n", price.Min, price.Max, price.Count)
+	}
+}
+
+// TestImportGoodsDryRun 只检查不保存，不存在的分类和重复的商品编号返回每一行的错误
+func TestImportGoodsDryRun() {
+	goods := func(sn string) *proto.CreateGoodsInfo {
+		return &proto.CreateGoodsInfo{GoodsSn: sn, Name: "导入测试商品", ShopPrice: 99}
+	}
+	rsp, err := brandClient.ImportGoods(context.Background(), &proto.ImportGoodsRequest{
+		DryRun: true,
+		Rows: []*proto.ImportGoodsRow{
+			{Line: 2, Category: "不存在的分类", Brand: "不存在的品牌", Goods: goods("import-1")},
+			{Line: 3, Category: "不存在的分类", Brand: "不存在的品牌", Goods: goods("import-1")},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	if len(rsp.Errors) != 2 || rsp.Created != 0 {
+		panic(fmt.Sprintf("应该返回两行的错误: %v", rsp.Errors))
+	}
+	for _, e := range rsp.Errors {
+		fmt.Printf("第%d行: %s\n", e.Line, e.Msg)
+	}
+}
+
+// TestUserFav 重复收藏和重复取消都不会改变收藏数
+func TestUserFav() {
+	ctx := context.Background()
+	goodsList, err := brandClient.GoodsList(ctx, &proto.GoodsFilterRequest{Pages: 1, PagePerNums: 1})
+	if err != nil {
+		panic(err)
+	}
+	if len(goodsList.Data) == 0 {
+		panic("没有商品")
+	}
+	goodsId := goodsList.Data[0].Id
+	favNum := func() int32 {
+		rsp, err := brandClient.GetGoodsDetail(ctx, &proto.GoodInfoRequest{Id: goodsId})
+		if err != nil {
+			panic(err)
+		}
+		return rsp.FavNum
+	}
+	req := &proto.UserFavRequest{UserId: 1, GoodsId: goodsId}
+	_, _ = brandClient.DeleteUserFav(ctx, req)
+	before := favNum()
+	for i := 0; i < 2; i++ {
+		if _, err := brandClient.AddUserFav(ctx, req); err != nil {
+			panic(err)
+		}
+	}
+	if got := favNum(); got != before+1 {
+		panic(fmt.Sprintf("收藏之后收藏数应该是%d，实际是%d", before+1, got))
+	}
+	check, err := brandClient.CheckUserFav(ctx, &proto.UserFavCheckRequest{UserId: 1, GoodsIds: []int32{goodsId}})
+	if err != nil {
+		panic(err)
+	}
+	if len(check.GoodsIds) != 1 {
+		panic("应该已经收藏")
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := brandClient.DeleteUserFav(ctx, req); err != nil {
+			panic(err)
+		}
+	}
+	if got := favNum(); got != before {
+		panic(fmt.Sprintf("取消收藏之后收藏数应该是%d，实际是%d", before, got))
+	}
+	rsp, err := brandClient.ReconcileFavNum(ctx, &proto.ReconcileFavRequest{GoodsIds: []int32{goodsId}})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("检查%d个商品，修正%d个\n", rsp.Checked, rsp.Fixed)
+}
+
+// TestReview 评分不合法、没有购买记录都不能评价，评价列表按照有用数排序
+func TestReview() {
+	ctx := context.Background()
+	if _, err := brandClient.CreateReview(ctx, &proto.ReviewRequest{UserId: 1, OrderGoodsId: 1, Rating: 6}); status.Code(err) != codes.InvalidArgument {
+		panic(fmt.Sprintf("评分超过5星应该返回参数错误: %v", err))
+	}
+	if _, err := brandClient.CreateReview(ctx, &proto.ReviewRequest{UserId: 1, OrderGoodsId: 1 << 30, Rating: 5}); status.Code(err) != codes.NotFound {
+		panic(fmt.Sprintf("不存在的订单商品应该返回NotFound: %v", err))
+	}
+	if _, err := brandClient.ReviewList(ctx, &proto.ReviewFilterRequest{Sort: "price"}); status.Code(err) != codes.InvalidArgument {
+		panic(fmt.Sprintf("不支持的排序方式应该返回参数错误: %v", err))
+	}
+	rsp, err := brandClient.ReviewList(ctx, &proto.ReviewFilterRequest{
+		Status: proto.ReviewStatus_REVIEW_APPROVED,
+		Sort:   "helpful",
+	})
+	if err != nil {
+		panic(err)
+	}
+	for i := 1; i < len(rsp.Data); i++ {
+		if rsp.Data[i].HelpfulNum > rsp.Data[i-1].HelpfulNum {
+			panic("评价没有按照有用数排序")
+		}
+	}
+	fmt.Println(rsp.Total)
+}
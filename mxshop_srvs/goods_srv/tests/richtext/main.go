@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+
+	"mxshop_srvs/goods_srv/utils"
+)
+
+/*
+	商品详情html过滤的测试，不依赖数据库
+*/
+
+func assertHTML(raw, want string) {
+	if got := utils.SanitizeHTML(raw); got != want {
+		panic(fmt.Sprintf("过滤 %q 应该返回 %q，实际返回 %q", raw, want, got))
+	}
+}
+
+// TestAllowedTags 白名单中的标签和属性原样保留
+func TestAllowedTags() {
+	assertHTML(`<p>好<strong>用</strong></p><img src="https://img.mxshop.com/1.jpg" alt="图">`,
+		`<p>好<strong>用</strong></p><img src="https://img.mxshop.com/1.jpg" alt="图">`)
+	assertHTML(`<td colspan="2" class="x">1</td>`, `<td colspan="2">1</td>`)
+}
+
+// TestDroppedTags 脚本连同内容去掉，其他标签去掉标签保留文字
+func TestDroppedTags() {
+	assertHTML(`<p>a<script>alert(1)</script>b</p>`, `<p>ab</p>`)
+	assertHTML(`<font color="red">红色</font>`, `红色`)
+	assertHTML(`<!-- 注释 --><style>p{}</style>文字`, `文字`)
+}
+
+// TestUnsafeAttrs 事件属性和非http的地址去掉
+func TestUnsafeAttrs() {
+	assertHTML(`<img src="javascript:alert(1)" onerror="alert(1)">`, `<img>`)
+	assertHTML(`<a href="https://www.mxshop.com" onclick="x()">链接</a>`,
+		`<a href="https://www.mxshop.com" rel="nofollow noopener" target="_blank">链接</a>`)
+	assertHTML(`<p>1 &lt; 2 &amp; "3"</p>`, `<p>1 &lt; 2 &amp; &#34;3&#34;</p>`)
+}
+
+// TestUnclosedTags 没有闭合的标签补上结束标签，多余的结束标签去掉
+func TestUnclosedTags() {
+	assertHTML(`<div><p>a`, `<div><p>a</p></div>`)
+	assertHTML(`a</p></div>`, `a`)
+	assertHTML(`<div><p>a</div>b`, `<div><p>a</p></div>b`)
+}
+
+func TestIsHttpUrl() {
+	for raw, want := range map[string]bool{
+		"https://img.mxshop.com/1.jpg": true,
+		"http://img.mxshop.com/1.jpg":  true,
+		"ftp://img.mxshop.com/1.jpg":   false,
+		"/static/1.jpg":                false,
+		"javascript:alert(1)":          false,
+	} {
+		if utils.IsHttpUrl(raw) != want {
+			panic(fmt.Sprintf("%s 的检查结果应该是%v", raw, want))
+		}
+	}
+}
+
+func main() {
+	TestAllowedTags()
+	TestDroppedTags()
+	TestUnsafeAttrs()
+	TestUnclosedTags()
+	TestIsHttpUrl()
+	fmt.Println("ok")
+}
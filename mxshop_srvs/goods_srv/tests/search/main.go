@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+
+	"mxshop_srvs/goods_srv/search"
+)
+
+/*
+	商品全文索引的测试，只测试索引本身，不依赖数据库
+*/
+
+var index = search.NewIndex()
+
+func Init() {
+	index.Rebuild([]search.Doc{
+		{Id: 1, Name: "华为 Mate60 手机", Brief: "麒麟芯片", Brand: "华为", Category: "手机"},
+		{Id: 2, Name: "苹果 iPhone15 Pro", Brief: "钛金属手机", Brand: "Apple", Category: "手机"},
+		{Id: 3, Name: "手机壳", Brief: "适用于华为手机", Brand: "品胜", Category: "手机配件"},
+		{Id: 4, Name: "新疆阿克苏苹果", Brief: "冰糖心", Brand: "果园", Category: "水果"},
+	})
+}
+
+func ids(query string) []int32 {
+	var result []int32
+	for _, r := range index.Search(query) {
+		result = append(result, r.Id)
+	}
+	return result
+}
+
+func assertIds(query string, want ...int32) {
+	got := ids(query)
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		panic(fmt.Sprintf("搜索 %q 应该返回%v，实际返回%v", query, want, got))
+	}
+}
+
+// TestRanking 名称中出现的关键词比简介中出现的排名更靠前
+func TestRanking() {
+	got := ids("华为手机")
+	if len(got) != 2 || got[0] != 1 {
+		panic(fmt.Sprintf("搜索华为手机应该优先返回华为手机，实际返回%v", got))
+	}
+	fmt.Println("按相关度排序")
+}
+
+// TestMixed 中英文混合和大小写
+func TestMixed() {
+	assertIds("IPHONE", 2)
+	assertIds("iphone 15", 2)
+	assertIds("apple", 2)
+	assertIds("阿克苏", 4)
+	assertIds("小米")
+	fmt.Println("中英文混合搜索")
+}
+
+// TestIncremental 增量更新之后立刻可以搜索到
+func TestIncremental() {
+	index.Add(search.Doc{Id: 5, Name: "小米14", Brand: "小米", Category: "手机"})
+	assertIds("小米", 5)
+	index.Add(search.Doc{Id: 5, Name: "红米 K70", Brand: "红米", Category: "手机"})
+	assertIds("小米")
+	assertIds("红米", 5)
+	index.Remove(5)
+	assertIds("红米")
+	fmt.Println("增量更新索引")
+}
+
+func main() {
+	Init()
+	TestRanking()
+	TestMixed()
+	TestIncremental()
+}
@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// allowedTags 商品详情允许的标签和每个标签允许的属性
+var allowedTags = map[string]map[string]bool{
+	"p": {}, "div": {}, "span": {}, "br": {}, "hr": {},
+	"h1": {}, "h2": {}, "h3": {}, "h4": {}, "h5": {}, "h6": {},
+	"strong": {}, "b": {}, "em": {}, "i": {}, "u": {}, "s": {},
+	"ul": {}, "ol": {}, "li": {}, "blockquote": {}, "pre": {}, "code": {},
+	"table": {}, "thead": {}, "tbody": {}, "tr": {},
+	"th":  {"colspan": true, "rowspan": true},
+	"td":  {"colspan": true, "rowspan": true},
+	"a":   {"href": true, "title": true},
+	"img": {"src": true, "alt": true, "width": true, "height": true},
+}
+
+// droppedTags 这些标签连同里面的内容一起去掉
+var droppedTags = map[string]bool{
+	"script": true, "style": true, "iframe": true, "frame": true, "frameset": true,
+	"object": true, "embed": true, "applet": true, "noscript": true, "template": true,
+	"textarea": true, "select": true, "svg": true, "math": true,
+}
+
+// voidTags 没有结束标签的元素
+var voidTags = map[string]bool{"br": true, "hr": true, "img": true}
+
+// IsHttpUrl 是否是http或者https的绝对地址
+func IsHttpUrl(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	return u.Scheme == "http" || u.Scheme == "https"
+}
+
+// SanitizeHTML 按照白名单过滤商品详情的html
+// 不在白名单中的标签去掉标签保留文字，脚本之类的标签连同内容一起去掉，链接和图片只允许http和https的地址
+// 没有闭合的标签在最后补上结束标签
+func SanitizeHTML(raw string) string {
+	var b strings.Builder
+	var open []string
+	skip := 0
+	z := html.NewTokenizer(strings.NewReader(raw))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		token := z.Token()
+		name := token.Data
+		switch tt {
+		case html.TextToken:
+			if skip == 0 {
+				b.WriteString(html.EscapeString(token.Data))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if droppedTags[name] {
+				if tt == html.StartTagToken && !voidTags[name] {
+					skip++
+				}
+				continue
+			}
+			attrs, ok := allowedTags[name]
+			if skip > 0 || !ok {
+				continue
+			}
+			writeStartTag(&b, name, token.Attr, attrs)
+			if !voidTags[name] {
+				open = append(open, name)
+			}
+		case html.EndTagToken:
+			if droppedTags[name] {
+				if skip > 0 {
+					skip--
+				}
+				continue
+			}
+			if skip > 0 || voidTags[name] {
+				continue
+			}
+			// 关闭到最近的同名标签，中间没有闭合的标签一起关闭
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] != name {
+					continue
+				}
+				for j := len(open) - 1; j >= i; j-- {
+					b.WriteString("</" + open[j] + ">")
+				}
+				open = open[:i]
+				break
+			}
+		}
+	}
+	for i := len(open) - 1; i >= 0; i-- {
+		b.WriteString("</" + open[i] + ">")
+	}
+	return b.String()
+}
+
+func writeStartTag(b *strings.Builder, name string, attrs []html.Attribute, allowed map[string]bool) {
+	b.WriteString("<" + name)
+	for _, attr := range attrs {
+		key := strings.ToLower(attr.Key)
+		if attr.Namespace != "" || !allowed[key] {
+			continue
+		}
+		if (key == "href" || key == "src") && !IsHttpUrl(strings.TrimSpace(attr.Val)) {
+			continue
+		}
+		b.WriteString(" " + key + `="` + html.EscapeString(attr.Val) + `"`)
+	}
+	if name == "a" {
+		b.WriteString(` rel="nofollow noopener" target="_blank"`)
+	}
+	b.WriteString(">")
+}
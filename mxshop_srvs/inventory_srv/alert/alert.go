@@ -0,0 +1,74 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+/*
+	低库存告警，扣减库存之后库存从不低于安全库存变为低于安全库存时发出一次告警
+	通知方式通过配置选择：
+		log    写入日志，由日志采集系统触发告警
+		memory 保存在内存中，测试使用
+*/
+
+const (
+	Log    = "log"
+	Memory = "memory"
+)
+
+// Event 低库存告警事件
+type Event struct {
+	GoodsId    int32
+	Warehouse  int32
+	Stocks     int32 // 扣减之后的库存
+	SafeStocks int32
+	OrderSn    string // 触发告警的订单
+	Time       time.Time
+}
+
+// Notifier 告警的通知方式，在扣减库存的事务提交之后调用，不能阻塞太久
+type Notifier interface {
+	Notify(ctx context.Context, event Event)
+}
+
+// New 根据名称创建通知方式，name为空使用log
+func New(name string) (Notifier, error) {
+	switch name {
+	case Log, "":
+		return &LogNotifier{}, nil
+	case Memory:
+		return &MemoryNotifier{}, nil
+	default:
+		return nil, fmt.Errorf("不支持的告警通知方式: %q", name)
+	}
+}
+
+type LogNotifier struct{}
+
+func (*LogNotifier) Notify(ctx context.Context, event Event) {
+	zap.S().Warnf("[低库存告警] 商品 %d 仓库 %d 库存 %d 低于安全库存 %d，订单 %s",
+		event.GoodsId, event.Warehouse, event.Stocks, event.SafeStocks, event.OrderSn)
+}
+
+type MemoryNotifier struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (m *MemoryNotifier) Notify(ctx context.Context, event Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, event)
+}
+
+// Events 返回收到的所有告警
+func (m *MemoryNotifier) Events() []Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Event(nil), m.events...)
+}
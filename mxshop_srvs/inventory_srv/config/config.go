@@ -13,12 +13,25 @@ type ConsulConfig struct {
 	Port int    `mapstructure:"port" json:"port"`
 }
 
+type RedisConfig struct {
+	Host string `mapstructure:"host" json:"host"`
+	Port int    `mapstructure:"port" json:"port"`
+}
+
 type ServerConfig struct {
 	Name       string       `mapstructure:"name" json:"name"`
 	Host       string       `mapstructure:"host" json:"host"`
 	Tags       []string     `mapstructure:"tags" json:"tags"`
 	MysqlInfo  MysqlConfig  `mapstructure:"mysql" json:"mysql"`
 	ConsulInfo ConsulConfig `mapstructure:"consul" json:"consul"`
+	RedisInfo  RedisConfig  `mapstructure:"redis" json:"redis"`
+
+	//库存扣减的策略：pessimistic(悲观锁)、optimistic(乐观锁)、redsync(redis分布式锁)、lua(redis预扣减)，不配置默认redsync
+	SellStrategy string `mapstructure:"sell_strategy" json:"sell_strategy"`
+	//仓库的分配策略：priority(按优先级)、nearest(优先收货地址所在省份的仓库，再按优先级)，不配置默认priority
+	AllocatePolicy string `mapstructure:"allocate_policy" json:"allocate_policy"`
+	//低库存告警的通知方式：log(日志)、memory(保存在内存中，测试使用)，不配置默认log
+	AlertNotifier string `mapstructure:"alert_notifier" json:"alert_notifier"`
 }
 
 type NacosConfig struct {
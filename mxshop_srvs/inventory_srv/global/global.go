@@ -1,12 +1,15 @@
 package global
 
 import (
+	goredislib "github.com/go-redis/redis/v8"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/gorm/schema"
 	"log"
+	"mxshop_srvs/inventory_srv/alert"
 	"mxshop_srvs/inventory_srv/config"
+	"mxshop_srvs/inventory_srv/stock"
 	"os"
 	"time"
 )
@@ -15,6 +18,9 @@ var (
 	DB           *gorm.DB
 	ServerConfig config.ServerConfig
 	NacosConfig  config.NacosConfig
+	RedisClient  *goredislib.Client
+	Strategy     stock.Strategy // 库存扣减策略
+	Notifier     alert.Notifier // 低库存告警
 )
 
 func init() {
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/inventory_srv/alert"
+	"mxshop_srvs/inventory_srv/global"
+	"mxshop_srvs/inventory_srv/model"
+	"mxshop_srvs/inventory_srv/proto"
+	"mxshop_srvs/inventory_srv/stock"
+)
+
+// SetSafeStocks 设置安全库存，没有指定仓库时设置这件商品所有仓库的安全库存
+func (*InventoryServer) SetSafeStocks(ctx context.Context, req *proto.SafeStocksInfo) (*emptypb.Empty, error) {
+	if req.SafeStocks < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "安全库存不能小于0")
+	}
+	result := global.DB.Model(&model.Inventory{}).Where(&model.Inventory{Goods: req.GoodsId, Warehouse: req.WarehouseId}).
+		Update("safe_stocks", req.SafeStocks)
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "设置安全库存失败")
+	}
+	if result.RowsAffected == 0 {
+		// 安全库存没有变化时也不会有影响的行，再确认一下库存信息是否存在
+		var total int64
+		global.DB.Model(&model.Inventory{}).Where(&model.Inventory{Goods: req.GoodsId, Warehouse: req.WarehouseId}).Count(&total)
+		if total == 0 {
+			return nil, status.Errorf(codes.NotFound, "库存信息不存在")
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// LowStockList 库存低于安全库存的商品，库存越少越靠前
+func (*InventoryServer) LowStockList(ctx context.Context, req *proto.LowStockRequest) (*proto.LowStockListResponse, error) {
+	query := global.DB.Model(&model.Inventory{}).Where("stocks < safe_stocks")
+	if req.WarehouseId > 0 {
+		query = query.Where("warehouse = ?", req.WarehouseId)
+	}
+
+	var total int64
+	query.Count(&total)
+
+	var invs []model.Inventory
+	if result := query.Order("stocks - safe_stocks, id").Scopes(Paginate(int(req.Pages), int(req.PagePerNums))).Find(&invs); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询低库存商品失败")
+	}
+
+	var warehouses []model.Warehouse
+	global.DB.Find(&warehouses)
+	names := make(map[int32]string, len(warehouses))
+	for _, warehouse := range warehouses {
+		names[warehouse.ID] = warehouse.Name
+	}
+
+	rsp := &proto.LowStockListResponse{Total: int32(total)}
+	for _, inv := range invs {
+		rsp.Data = append(rsp.Data, &proto.LowStockInfo{
+			GoodsId:       inv.Goods,
+			WarehouseId:   inv.Warehouse,
+			WarehouseName: names[inv.Warehouse],
+			Stocks:        inv.Stocks,
+			SafeStocks:    inv.SafeStocks,
+		})
+	}
+	return rsp, nil
+}
+
+// crossedSafeStocks 查询扣减之后低于安全库存的商品，需要和扣减库存在同一个事务中
+// 只有扣减之前不低于安全库存的才告警，已经低于安全库存的商品不会每次扣减都告警
+func crossedSafeStocks(tx *gorm.DB, orderSn string, items []*stock.Item) ([]alert.Event, error) {
+	var events []alert.Event
+	for _, item := range items {
+		var inv model.Inventory
+		if result := tx.Where(&model.Inventory{Goods: item.GoodsId, Warehouse: item.Warehouse}).First(&inv); result.Error != nil {
+			return nil, result.Error
+		}
+		if inv.Stocks < inv.SafeStocks && inv.Stocks+item.Num >= inv.SafeStocks {
+			events = append(events, alert.Event{
+				GoodsId:    inv.Goods,
+				Warehouse:  inv.Warehouse,
+				Stocks:     inv.Stocks,
+				SafeStocks: inv.SafeStocks,
+				OrderSn:    orderSn,
+				Time:       time.Now(),
+			})
+		}
+	}
+	return events, nil
+}
+
+// notifyLowStocks 扣减的事务提交之后发出告警
+func notifyLowStocks(ctx context.Context, events []alert.Event) {
+	if global.Notifier == nil {
+		return
+	}
+	for _, event := range events {
+		global.Notifier.Notify(ctx, event)
+	}
+}
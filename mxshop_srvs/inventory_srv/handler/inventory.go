@@ -2,162 +2,202 @@ package handler
 
 import (
 	"context"
-	"fmt"
-	goredislib "github.com/go-redis/redis/v8"
-	"github.com/go-redsync/redsync/v4"
-	"github.com/go-redsync/redsync/v4/redis/goredis/v8"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"mxshop_srvs/inventory_srv/alert"
 	"mxshop_srvs/inventory_srv/global"
 	"mxshop_srvs/inventory_srv/model"
 	"mxshop_srvs/inventory_srv/proto"
+	"mxshop_srvs/inventory_srv/stock"
 )
 
 type InventoryServer struct {
 	proto.UnimplementedInventoryServer
 }
 
-// SetInv 设置库存
+// SetInv 设置库存，没有指定仓库时设置默认仓库的库存
 func (*InventoryServer) SetInv(ctx context.Context, req *proto.GoodsInvInfo) (*emptypb.Empty, error) {
+	warehouseId := req.WarehouseId
+	if warehouseId == 0 {
+		id, err := defaultWarehouse()
+		if err != nil {
+			return nil, err
+		}
+		warehouseId = id
+	} else if result := global.DB.First(&model.Warehouse{}, warehouseId); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "仓库不存在")
+	}
+
 	//设置库存， 如果我要更新库存
 	var inv model.Inventory
-	global.DB.Where(&model.Inventory{Goods: req.GoodsId}).First(&inv)
+	global.DB.Where(&model.Inventory{Goods: req.GoodsId, Warehouse: warehouseId}).First(&inv)
 	inv.Goods = req.GoodsId
+	inv.Warehouse = warehouseId
 	inv.Stocks = req.Num
 
 	global.DB.Save(&inv)
+	if cache, ok := global.Strategy.(stock.Cache); ok {
+		var total int64
+		global.DB.Model(&model.Inventory{}).Where(&model.Inventory{Goods: req.GoodsId}).
+			Select("coalesce(sum(stocks), 0)").Scan(&total)
+		cache.Set(ctx, req.GoodsId, int32(total))
+	}
 	return &emptypb.Empty{}, nil
 }
 
+// DeleteInv 删除商品在所有仓库的库存，直接删除记录，之后同样的商品id可以重新设置库存
+// 扣减记录保留，之前下单的订单关闭时归还不会报错，只是没有库存可以增加
+func (*InventoryServer) DeleteInv(ctx context.Context, req *proto.DeleteInvInfo) (*emptypb.Empty, error) {
+	if len(req.GoodsIds) == 0 {
+		return &emptypb.Empty{}, nil
+	}
+	if result := global.DB.Unscoped().Where("goods IN ?", req.GoodsIds).Delete(&model.Inventory{}); result.Error != nil {
+		zap.S().Errorw("[DeleteInv] 删除库存失败", "goods", req.GoodsIds, "msg", result.Error.Error())
+		return nil, status.Errorf(codes.Internal, "删除库存失败")
+	}
+	if cache, ok := global.Strategy.(stock.Cache); ok {
+		for _, goodsId := range req.GoodsIds {
+			cache.Set(ctx, goodsId, 0)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// InvDetail 商品的总库存和每个仓库的库存
 func (*InventoryServer) InvDetail(ctx context.Context, req *proto.GoodsInvInfo) (*proto.GoodsInvInfo, error) {
-	var inv model.Inventory
-	if result := global.DB.First(&inv, req.GoodsId); result.RowsAffected == 0 {
+	var invs []model.Inventory
+	if result := global.DB.Where(&model.Inventory{Goods: req.GoodsId}).Order("warehouse").Find(&invs); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "库存信息不存在")
 	}
-	return &proto.GoodsInvInfo{
-		GoodsId: inv.Goods,
-		Num:     inv.Stocks,
-	}, nil
-}
 
-//
-//// Sell 库存扣减
-//// Mysql 悲观锁版本
-//func (*InventoryServer) Sell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
-//	// 扣减库存，本地事务
-//	// 数据库基本的一个应用场景：数据库事务
-//	// 并发情况之下 可能会出现超卖
-//	tx := global.DB.Begin()
-//	for _, goodInfo := range req.GoodsInfo {
-//		var inv model.Inventory
-//		if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.Inventory{Goods: goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-//			tx.Rollback() // 回滚之前的操作
-//			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
-//		}
-//		// 判断库存是否充足
-//		if inv.Stocks < goodInfo.Num {
-//			tx.Rollback() // 回滚之前的操作
-//			return nil, status.Errorf(codes.ResourceExhausted, "库存不足")
-//		}
-//		// 扣减，这里会出现数据不一致的问题
-//		inv.Stocks -= goodInfo.Num
-//		tx.Save(&inv) // 一旦使用了事务的，保存修改数据库的操作就需要使用事务的tx，而不能使用db
-//	}
-//	tx.Commit() // 需要自己手动提交操作
-//	return &emptypb.Empty{}, nil
-//}
+	var warehouses []model.Warehouse
+	global.DB.Find(&warehouses)
+	names := make(map[int32]string, len(warehouses))
+	for _, warehouse := range warehouses {
+		names[warehouse.ID] = warehouse.Name
+	}
 
-// Mysql 乐观锁版本
-// 利用版本号机制来实现乐观锁
-//func (*InventoryServer) Sell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
-//	// 扣减库存，本地事务
-//	// 数据库基本的一个应用场景：数据库事务
-//	// 并发情况之下 可能会出现超卖 1
-//	tx := global.DB.Begin()
-//	for _, goodInfo := range req.GoodsInfo {
-//		var inv model.Inventory
-//
-//		for {
-//			if result := global.DB.Where(&model.Inventory{Goods: goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-//				tx.Rollback() // 回滚之前的操作
-//				return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
-//			}
-//			// 判断库存是否充足
-//			if inv.Stocks < goodInfo.Num {
-//				tx.Rollback() // 回滚之前的操作
-//				return nil, status.Errorf(codes.ResourceExhausted, "库存不足")
-//			}
-//			// 扣减，这里会出现数据不一致的问题
-//			inv.Stocks -= goodInfo.Num
-//			//update inventory set stocks = stocks-1, version=version+1 where goods=goods and version=version
-//			//这种写法有瑕疵，为什么？
-//			//零值 对于int类型来说 默认值是0 这种会被gorm给忽略掉
-//			//解决方案：试用gorm提供是select写法强制更新指定的字段
-//			if result := tx.Model(&model.Inventory{}).Select("Stocks", "Version").Where("goods = ? and version= ?",
-//				goodInfo.GoodsId, inv.Version).Updates(model.Inventory{Stocks: inv.Stocks, Version: inv.Version + 1}); result.RowsAffected == 0 {
-//				zap.S().Info("库存扣减失败")
-//			} else {
-//				break
-//			}
-//		}
-//	}
-//	tx.Commit() // 需要自己手动提交操作
-//	return &emptypb.Empty{}, nil
-//}
+	rsp := &proto.GoodsInvInfo{GoodsId: req.GoodsId}
+	for _, inv := range invs {
+		rsp.Num += inv.Stocks
+		rsp.Warehouses = append(rsp.Warehouses, &proto.WarehouseStock{
+			WarehouseId: inv.Warehouse,
+			Name:        names[inv.Warehouse],
+			Num:         inv.Stocks,
+		})
+	}
+	return rsp, nil
+}
 
-// Redis 分布式锁
-func (*InventoryServer) Sell(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
-	client := goredislib.NewClient(&goredislib.Options{
-		Addr: "127.0.0.1:6379",
-	})
-	pool := goredis.NewPool(client) // or, pool := redigo.NewPool(...)
-	rs := redsync.New(pool)
+// Sell 库存扣减，扣减的方式由配置的策略决定，发货的仓库由配置的分配策略决定
+// 扣减记录和库存在同一个事务中写入，同一个订单只会扣减一次，重复扣减返回第一次分配的仓库
+func (*InventoryServer) Sell(ctx context.Context, req *proto.SellInfo) (*proto.SellInfo, error) {
+	if req.OrderSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "订单号不能为空")
+	}
+	details, err := checkSold(req.OrderSn)
+	if err != nil {
+		return nil, err
+	}
+	if len(details) > 0 {
+		return sellResult(req.OrderSn, details), nil
+	}
 
-	tx := global.DB.Begin()
-	for _, goodInfo := range req.GoodsInfo {
-		var inv model.Inventory
-		mutex := rs.NewMutex(fmt.Sprintf("goods_%d", goodInfo.GoodsId))
-		if err := mutex.Lock(); err != nil {
-			return nil, status.Errorf(codes.Internal, "获取redis分布式锁异常")
+	warehouses, err := candidateWarehouses(req.Province)
+	if err != nil {
+		return nil, err
+	}
+	var items []*stock.Item
+	for _, goodInfo := range mergeGoods(req.GoodsInfo) {
+		items = append(items, &stock.Item{GoodsId: goodInfo.GoodsId, Num: goodInfo.Num, Warehouses: warehouses})
+	}
+	var events []alert.Event
+	err = global.Strategy.Sell(ctx, items, func(tx *gorm.DB) error {
+		if err := recordSell(tx, req.OrderSn, items); err != nil {
+			return err
 		}
-		if result := global.DB.Where(&model.Inventory{Goods: goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-			tx.Rollback() //回滚之前的操作
-			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
+		var err error
+		events, err = crossedSafeStocks(tx, req.OrderSn, items)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
 		}
-		//判断库存是否充足
-		if inv.Stocks < goodInfo.Num {
-			tx.Rollback() //回滚之前的操作
-			return nil, status.Errorf(codes.ResourceExhausted, "库存不足")
+		// 同一个订单并发扣减，唯一索引冲突的一方回滚之后按照已经扣减处理
+		if details, _ = checkSold(req.OrderSn); len(details) > 0 {
+			return sellResult(req.OrderSn, details), nil
 		}
-		//扣减， 会出现数据不一致的问题 - 锁，分布式锁
-		inv.Stocks -= goodInfo.Num
-		tx.Save(&inv)
+		zap.S().Errorf("[Sell] 订单 %s 扣减库存失败: %s", req.OrderSn, err.Error())
+		return nil, status.Errorf(codes.Internal, "扣减库存失败")
+	}
+	notifyLowStocks(ctx, events)
 
-		if ok, err := mutex.Unlock(); !ok || err != nil {
-			return nil, status.Errorf(codes.Internal, "释放redis分布式锁异常")
-		}
+	rsp := &proto.SellInfo{OrderSn: req.OrderSn}
+	for _, item := range items {
+		rsp.GoodsInfo = append(rsp.GoodsInfo, &proto.GoodsInvInfo{GoodsId: item.GoodsId, Num: item.Num, WarehouseId: item.Warehouse})
 	}
-	tx.Commit() // 需要自己手动提交操作
-	//m.Unlock() //释放锁
-	return &emptypb.Empty{}, nil
+	return rsp, nil
 }
 
 // Reback 库存归还
+// 只归还这个订单扣减过并且还没有归还的库存，同一个订单重复归还不会多加库存
+// 没有扣减记录的商品(扣减请求失败或者还没有到达)会记录一条已归还，之后到达的扣减请求不会再扣减库存
 func (*InventoryServer) Reback(ctx context.Context, req *proto.SellInfo) (*emptypb.Empty, error) {
 	//库存归还： 1：订单超时归还 2. 订单创建失败，归还之前扣减的库存 3. 手动归还
-	tx := global.DB.Begin()
-	for _, goodInfo := range req.GoodsInfo {
-		var inv model.Inventory
-		if result := global.DB.Where(&model.Inventory{Goods: goodInfo.GoodsId}).First(&inv); result.RowsAffected == 0 {
-			tx.Rollback() //回滚之前的操作
-			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
+	if req.OrderSn == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "订单号不能为空")
+	}
+	var returned []*proto.GoodsInvInfo
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		var details []model.StockSellDetail
+		if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(&model.StockSellDetail{OrderSn: req.OrderSn}).Find(&details); result.Error != nil {
+			return result.Error
+		}
+
+		recorded := make(map[int32]bool)
+		for _, detail := range details {
+			recorded[detail.Goods] = true
+			if detail.Status != model.SellDeducted {
+				continue
+			}
+			// 归还到扣减的仓库
+			if result := tx.Model(&model.Inventory{}).Where(&model.Inventory{Goods: detail.Goods, Warehouse: detail.Warehouse}).
+				Update("stocks", gorm.Expr("stocks + ?", detail.Num)); result.Error != nil {
+				return result.Error
+			}
+			if result := tx.Model(&model.StockSellDetail{}).Where("id = ?", detail.ID).
+				Update("status", model.SellReturned); result.Error != nil {
+				return result.Error
+			}
+			returned = append(returned, &proto.GoodsInvInfo{GoodsId: detail.Goods, Num: detail.Num})
 		}
 
-		//扣减， 会出现数据不一致的问题 - 锁，分布式锁
-		inv.Stocks += goodInfo.Num
-		tx.Save(&inv)
+		for _, goodInfo := range mergeGoods(req.GoodsInfo) {
+			if recorded[goodInfo.GoodsId] {
+				continue
+			}
+			if result := tx.Create(&model.StockSellDetail{
+				OrderSn: req.OrderSn,
+				Goods:   goodInfo.GoodsId,
+				Status:  model.SellReturned,
+			}); result.Error != nil {
+				return result.Error
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		zap.S().Errorf("[Reback] 订单 %s 归还库存失败: %s", req.OrderSn, err.Error())
+		return nil, status.Errorf(codes.Internal, "归还库存失败")
+	}
+	if cache, ok := global.Strategy.(stock.Cache); ok {
+		cache.Add(ctx, returned)
 	}
-	tx.Commit() // 需要自己手动提交操作
 	return &emptypb.Empty{}, nil
-}
\ No newline at end of file
+}
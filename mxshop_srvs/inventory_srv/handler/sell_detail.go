@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/inventory_srv/global"
+	"mxshop_srvs/inventory_srv/model"
+	"mxshop_srvs/inventory_srv/proto"
+	"mxshop_srvs/inventory_srv/stock"
+)
+
+// checkSold 根据扣减记录判断订单能否扣减库存
+// 已经扣减过返回扣减记录，已经归还过的订单不能再扣减
+func checkSold(orderSn string) ([]model.StockSellDetail, error) {
+	var details []model.StockSellDetail
+	if result := global.DB.Where(&model.StockSellDetail{OrderSn: orderSn}).Find(&details); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询库存扣减记录失败")
+	}
+	for _, detail := range details {
+		if detail.Status == model.SellReturned {
+			return nil, status.Errorf(codes.FailedPrecondition, "订单的库存已经归还")
+		}
+	}
+	return details, nil
+}
+
+// recordSell 记录订单每件商品扣减的数量和发货的仓库，需要和扣减库存在同一个事务中
+func recordSell(tx *gorm.DB, orderSn string, items []*stock.Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+	details := make([]model.StockSellDetail, 0, len(items))
+	for _, item := range items {
+		details = append(details, model.StockSellDetail{
+			OrderSn:   orderSn,
+			Goods:     item.GoodsId,
+			Warehouse: item.Warehouse,
+			Num:       item.Num,
+			Status:    model.SellDeducted,
+		})
+	}
+	return tx.CreateInBatches(details, 100).Error
+}
+
+// sellResult 根据扣减记录返回每件商品发货的仓库
+func sellResult(orderSn string, details []model.StockSellDetail) *proto.SellInfo {
+	rsp := &proto.SellInfo{OrderSn: orderSn}
+	for _, detail := range details {
+		rsp.GoodsInfo = append(rsp.GoodsInfo, &proto.GoodsInvInfo{
+			GoodsId:     detail.Goods,
+			Num:         detail.Num,
+			WarehouseId: detail.Warehouse,
+		})
+	}
+	return rsp
+}
+
+// mergeGoods 合并同一件商品的数量，并且按照商品id排序，多个订单按照同样的顺序加锁不会互相等待
+func mergeGoods(goodsInfo []*proto.GoodsInvInfo) []*proto.GoodsInvInfo {
+	nums := make(map[int32]int32)
+	for _, goodInfo := range goodsInfo {
+		nums[goodInfo.GoodsId] += goodInfo.Num
+	}
+	merged := make([]*proto.GoodsInvInfo, 0, len(nums))
+	for goodsId, num := range nums {
+		merged = append(merged, &proto.GoodsInvInfo{GoodsId: goodsId, Num: num})
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		return merged[i].GoodsId < merged[j].GoodsId
+	})
+	return merged
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/inventory_srv/global"
+	"mxshop_srvs/inventory_srv/model"
+	"mxshop_srvs/inventory_srv/proto"
+)
+
+// 仓库的分配策略
+const (
+	AllocatePriority = "priority"
+	AllocateNearest  = "nearest"
+)
+
+func WarehouseToResponse(warehouse model.Warehouse) *proto.WarehouseInfo {
+	return &proto.WarehouseInfo{
+		Id:        warehouse.ID,
+		Name:      warehouse.Name,
+		Province:  warehouse.Province,
+		Priority:  warehouse.Priority,
+		IsDefault: warehouse.IsDefault,
+	}
+}
+
+// WarehouseList 仓库列表
+func (*InventoryServer) WarehouseList(ctx context.Context, req *emptypb.Empty) (*proto.WarehouseListResponse, error) {
+	var warehouses []model.Warehouse
+	if result := global.DB.Order("priority desc, id").Find(&warehouses); result.Error != nil {
+		return nil, result.Error
+	}
+	rsp := &proto.WarehouseListResponse{Total: int32(len(warehouses))}
+	for _, warehouse := range warehouses {
+		rsp.Data = append(rsp.Data, WarehouseToResponse(warehouse))
+	}
+	return rsp, nil
+}
+
+// CreateWarehouse 新建仓库
+func (*InventoryServer) CreateWarehouse(ctx context.Context, req *proto.WarehouseInfo) (*proto.WarehouseInfo, error) {
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "仓库名称不能为空")
+	}
+	warehouse := model.Warehouse{
+		Name:      req.Name,
+		Province:  normalizeProvince(req.Province),
+		Priority:  req.Priority,
+		IsDefault: req.IsDefault,
+	}
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&warehouse).Error; err != nil {
+			return err
+		}
+		return keepOneDefault(tx, warehouse)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "新建仓库失败")
+	}
+	return WarehouseToResponse(warehouse), nil
+}
+
+// UpdateWarehouse 修改仓库
+func (*InventoryServer) UpdateWarehouse(ctx context.Context, req *proto.WarehouseInfo) (*emptypb.Empty, error) {
+	var warehouse model.Warehouse
+	if result := global.DB.First(&warehouse, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "仓库不存在")
+	}
+	if warehouse.IsDefault && !req.IsDefault {
+		return nil, status.Errorf(codes.FailedPrecondition, "不能取消默认仓库，请设置其他仓库为默认仓库")
+	}
+	if req.Name != "" {
+		warehouse.Name = req.Name
+	}
+	warehouse.Province = normalizeProvince(req.Province)
+	warehouse.Priority = req.Priority
+	warehouse.IsDefault = req.IsDefault
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&warehouse).Error; err != nil {
+			return err
+		}
+		return keepOneDefault(tx, warehouse)
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "修改仓库失败")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// keepOneDefault 设置了新的默认仓库之后取消其他仓库的默认
+func keepOneDefault(tx *gorm.DB, warehouse model.Warehouse) error {
+	if !warehouse.IsDefault {
+		return nil
+	}
+	return tx.Model(&model.Warehouse{}).Where("id <> ? and is_default = ?", warehouse.ID, true).
+		Update("is_default", false).Error
+}
+
+// defaultWarehouse 设置库存时没有指定仓库使用默认仓库
+func defaultWarehouse() (int32, error) {
+	var warehouse model.Warehouse
+	if result := global.DB.Where(&model.Warehouse{IsDefault: true}).First(&warehouse); result.RowsAffected == 0 {
+		return 0, status.Errorf(codes.FailedPrecondition, "没有设置默认仓库")
+	}
+	return warehouse.ID, nil
+}
+
+// candidateWarehouses 按照配置的分配策略给仓库排序，扣减库存时依次尝试
+func candidateWarehouses(province string) ([]int32, error) {
+	var warehouses []model.Warehouse
+	if result := global.DB.Order("priority desc, id").Find(&warehouses); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询仓库失败")
+	}
+
+	province = normalizeProvince(province)
+	if global.ServerConfig.AllocatePolicy == AllocateNearest && province != "" {
+		// 同省的仓库排在前面，同省和不同省的仓库内部仍然按照优先级排序
+		sort.SliceStable(warehouses, func(i, j int) bool {
+			return warehouses[i].Province == province && warehouses[j].Province != province
+		})
+	}
+
+	ids := make([]int32, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		ids = append(ids, warehouse.ID)
+	}
+	return ids, nil
+}
+
+// provinceSuffixes 省份名称的后缀，比较时去掉，"广东省"和"广东"认为是同一个省份
+var provinceSuffixes = []string{"壮族自治区", "回族自治区", "维吾尔自治区", "特别行政区", "自治区", "省", "市"}
+
+func normalizeProvince(province string) string {
+	province = strings.TrimSpace(province)
+	for _, suffix := range provinceSuffixes {
+		if strings.HasSuffix(province, suffix) {
+			return strings.TrimSuffix(province, suffix)
+		}
+	}
+	return province
+}
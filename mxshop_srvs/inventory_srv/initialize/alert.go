@@ -0,0 +1,17 @@
+package initialize
+
+import (
+	"go.uber.org/zap"
+
+	"mxshop_srvs/inventory_srv/alert"
+	"mxshop_srvs/inventory_srv/global"
+)
+
+// InitNotifier 根据配置选择低库存告警的通知方式
+func InitNotifier() {
+	notifier, err := alert.New(global.ServerConfig.AlertNotifier)
+	if err != nil {
+		zap.S().Panic("初始化低库存告警失败:", err.Error())
+	}
+	global.Notifier = notifier
+}
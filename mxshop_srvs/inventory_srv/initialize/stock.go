@@ -0,0 +1,29 @@
+package initialize
+
+import (
+	"fmt"
+
+	goredislib "github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"mxshop_srvs/inventory_srv/global"
+	"mxshop_srvs/inventory_srv/stock"
+)
+
+// InitRedis 所有请求共用一个redis连接池
+func InitRedis() {
+	c := global.ServerConfig.RedisInfo
+	global.RedisClient = goredislib.NewClient(&goredislib.Options{
+		Addr: fmt.Sprintf("%s:%d", c.Host, c.Port),
+	})
+}
+
+// InitStrategy 根据配置选择库存扣减的策略
+func InitStrategy() {
+	strategy, err := stock.New(global.ServerConfig.SellStrategy, global.DB, global.RedisClient)
+	if err != nil {
+		zap.S().Panic("初始化库存扣减策略失败:", err.Error())
+	}
+	global.Strategy = strategy
+	zap.S().Infof("库存扣减策略：%s", global.ServerConfig.SellStrategy)
+}
@@ -27,6 +27,9 @@ func main() {
 	initialize.InitLogger()
 	initialize.InitConfig()
 	initialize.InitDB()
+	initialize.InitRedis()
+	initialize.InitStrategy()
+	initialize.InitNotifier()
 
 	IP := flag.String("ip", "0.0.0.0", "ip地址")
 	Port := flag.Int("port", 50059, "端口号") // 这个修改为0，如果我们从命令行带参数启动的话就不会为0
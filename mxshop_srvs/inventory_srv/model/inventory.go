@@ -1,8 +1,38 @@
 package model
 
+// Warehouse 仓库，扣减库存时按照配置的策略选择发货的仓库
+type Warehouse struct {
+	BaseModel
+	Name      string `gorm:"type:varchar(50);not null"`
+	Province  string `gorm:"type:varchar(20)"` // 所在的省份，就近发货时使用
+	Priority  int32  `gorm:"type:int;default:0"`
+	IsDefault bool   // 设置库存时没有指定仓库使用默认仓库
+}
+
+// Inventory 每个仓库中每件商品的库存
 type Inventory struct {
 	BaseModel
-	Goods   int32 `gorm:"type:int;index"` // 商品id
-	Stocks  int32 `gorm:"type:int"`       // 库存
-	Version int32 `gorm:"type:int"`       //分布式锁的乐观锁
-}
\ No newline at end of file
+	Goods     int32 `gorm:"type:int;index;index:idx_goods_warehouse,unique"` // 商品id
+	Warehouse int32 `gorm:"type:int;index:idx_goods_warehouse,unique"`       // 仓库id
+	Stocks    int32 `gorm:"type:int"`                                        // 库存
+	Version   int32 `gorm:"type:int"`                                        //分布式锁的乐观锁
+
+	SafeStocks int32 `gorm:"type:int;default:0"` // 安全库存，库存低于安全库存时告警，0表示不告警
+}
+
+// 库存扣减记录的状态
+const (
+	SellDeducted = "DEDUCTED" // 已扣减
+	SellReturned = "RETURNED" // 已归还
+)
+
+// StockSellDetail 订单扣减库存的记录，每个订单的每件商品一条
+// 扣减和归还都以这张表为准：同一个订单重复扣减直接返回成功，归还时只归还这个订单实际扣减过的数量
+type StockSellDetail struct {
+	BaseModel
+	OrderSn   string `gorm:"type:varchar(30);index:idx_order_goods,unique"`
+	Goods     int32  `gorm:"type:int;index:idx_order_goods,unique"`
+	Warehouse int32  `gorm:"type:int"` // 发货的仓库，归还时还回这个仓库
+	Num       int32  `gorm:"type:int"`
+	Status    string `gorm:"type:varchar(20) comment 'DEDUCTED(已扣减), RETURNED(已归还)'"`
+}
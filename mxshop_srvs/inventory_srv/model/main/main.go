@@ -34,5 +34,14 @@ func main() {
 		panic(err)
 	}
 
-	_ = db.AutoMigrate(&model.Inventory{})
+	_ = db.AutoMigrate(&model.Warehouse{}, &model.Inventory{}, &model.StockSellDetail{})
+
+	// 多仓库之前的库存都属于默认仓库
+	var warehouse model.Warehouse
+	if result := db.Where(&model.Warehouse{IsDefault: true}).First(&warehouse); result.RowsAffected == 0 {
+		warehouse = model.Warehouse{Name: "默认仓库", IsDefault: true}
+		db.Create(&warehouse)
+	}
+	db.Model(&model.Inventory{}).Where("warehouse = 0").Update("warehouse", warehouse.ID)
+	db.Model(&model.StockSellDetail{}).Where("warehouse = 0").Update("warehouse", warehouse.ID)
 }
\ No newline at end of file
@@ -20,10 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Inventory_SetInv_FullMethodName    = "/Inventory/SetInv"
-	Inventory_InvDetail_FullMethodName = "/Inventory/InvDetail"
-	Inventory_Sell_FullMethodName      = "/Inventory/Sell"
-	Inventory_Reback_FullMethodName    = "/Inventory/Reback"
+	Inventory_SetInv_FullMethodName          = "/Inventory/SetInv"
+	Inventory_InvDetail_FullMethodName       = "/Inventory/InvDetail"
+	Inventory_Sell_FullMethodName            = "/Inventory/Sell"
+	Inventory_Reback_FullMethodName          = "/Inventory/Reback"
+	Inventory_DeleteInv_FullMethodName       = "/Inventory/DeleteInv"
+	Inventory_WarehouseList_FullMethodName   = "/Inventory/WarehouseList"
+	Inventory_CreateWarehouse_FullMethodName = "/Inventory/CreateWarehouse"
+	Inventory_UpdateWarehouse_FullMethodName = "/Inventory/UpdateWarehouse"
+	Inventory_SetSafeStocks_FullMethodName   = "/Inventory/SetSafeStocks"
+	Inventory_LowStockList_FullMethodName    = "/Inventory/LowStockList"
 )
 
 // InventoryClient is the client API for Inventory service.
@@ -33,8 +39,16 @@ type InventoryClient interface {
 	SetInv(ctx context.Context, in *GoodsInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	InvDetail(ctx context.Context, in *GoodsInvInfo, opts ...grpc.CallOption) (*GoodsInvInfo, error)
 	// 购买的时候，有可能是从购物车购买的，这就可能涉及到多件商品的购买库存；这里还涉及到了分布式事务
-	Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*SellInfo, error)
 	Reback(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteInv(ctx context.Context, in *DeleteInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 仓库
+	WarehouseList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WarehouseListResponse, error)
+	CreateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*WarehouseInfo, error)
+	UpdateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 安全库存
+	SetSafeStocks(ctx context.Context, in *SafeStocksInfo, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LowStockList(ctx context.Context, in *LowStockRequest, opts ...grpc.CallOption) (*LowStockListResponse, error)
 }
 
 type inventoryClient struct {
@@ -65,9 +79,9 @@ func (c *inventoryClient) InvDetail(ctx context.Context, in *GoodsInvInfo, opts
 	return out, nil
 }
 
-func (c *inventoryClient) Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *inventoryClient) Sell(ctx context.Context, in *SellInfo, opts ...grpc.CallOption) (*SellInfo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(SellInfo)
 	err := c.cc.Invoke(ctx, Inventory_Sell_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -85,6 +99,66 @@ func (c *inventoryClient) Reback(ctx context.Context, in *SellInfo, opts ...grpc
 	return out, nil
 }
 
+func (c *inventoryClient) DeleteInv(ctx context.Context, in *DeleteInvInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Inventory_DeleteInv_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) WarehouseList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WarehouseListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarehouseListResponse)
+	err := c.cc.Invoke(ctx, Inventory_WarehouseList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) CreateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*WarehouseInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarehouseInfo)
+	err := c.cc.Invoke(ctx, Inventory_CreateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) UpdateWarehouse(ctx context.Context, in *WarehouseInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Inventory_UpdateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) SetSafeStocks(ctx context.Context, in *SafeStocksInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Inventory_SetSafeStocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryClient) LowStockList(ctx context.Context, in *LowStockRequest, opts ...grpc.CallOption) (*LowStockListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LowStockListResponse)
+	err := c.cc.Invoke(ctx, Inventory_LowStockList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServer is the server API for Inventory service.
 // All implementations must embed UnimplementedInventoryServer
 // for forward compatibility.
@@ -92,8 +166,16 @@ type InventoryServer interface {
 	SetInv(context.Context, *GoodsInvInfo) (*emptypb.Empty, error)
 	InvDetail(context.Context, *GoodsInvInfo) (*GoodsInvInfo, error)
 	// 购买的时候，有可能是从购物车购买的，这就可能涉及到多件商品的购买库存；这里还涉及到了分布式事务
-	Sell(context.Context, *SellInfo) (*emptypb.Empty, error)
+	Sell(context.Context, *SellInfo) (*SellInfo, error)
 	Reback(context.Context, *SellInfo) (*emptypb.Empty, error)
+	DeleteInv(context.Context, *DeleteInvInfo) (*emptypb.Empty, error)
+	// 仓库
+	WarehouseList(context.Context, *emptypb.Empty) (*WarehouseListResponse, error)
+	CreateWarehouse(context.Context, *WarehouseInfo) (*WarehouseInfo, error)
+	UpdateWarehouse(context.Context, *WarehouseInfo) (*emptypb.Empty, error)
+	// 安全库存
+	SetSafeStocks(context.Context, *SafeStocksInfo) (*emptypb.Empty, error)
+	LowStockList(context.Context, *LowStockRequest) (*LowStockListResponse, error)
 	mustEmbedUnimplementedInventoryServer()
 }
 
@@ -110,12 +192,30 @@ func (UnimplementedInventoryServer) SetInv(context.Context, *GoodsInvInfo) (*emp
 func (UnimplementedInventoryServer) InvDetail(context.Context, *GoodsInvInfo) (*GoodsInvInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvDetail not implemented")
 }
-func (UnimplementedInventoryServer) Sell(context.Context, *SellInfo) (*emptypb.Empty, error) {
+func (UnimplementedInventoryServer) Sell(context.Context, *SellInfo) (*SellInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sell not implemented")
 }
 func (UnimplementedInventoryServer) Reback(context.Context, *SellInfo) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reback not implemented")
 }
+func (UnimplementedInventoryServer) DeleteInv(context.Context, *DeleteInvInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInv not implemented")
+}
+func (UnimplementedInventoryServer) WarehouseList(context.Context, *emptypb.Empty) (*WarehouseListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarehouseList not implemented")
+}
+func (UnimplementedInventoryServer) CreateWarehouse(context.Context, *WarehouseInfo) (*WarehouseInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedInventoryServer) UpdateWarehouse(context.Context, *WarehouseInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWarehouse not implemented")
+}
+func (UnimplementedInventoryServer) SetSafeStocks(context.Context, *SafeStocksInfo) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSafeStocks not implemented")
+}
+func (UnimplementedInventoryServer) LowStockList(context.Context, *LowStockRequest) (*LowStockListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LowStockList not implemented")
+}
 func (UnimplementedInventoryServer) mustEmbedUnimplementedInventoryServer() {}
 func (UnimplementedInventoryServer) testEmbeddedByValue()                   {}
 
@@ -209,6 +309,114 @@ func _Inventory_Reback_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Inventory_DeleteInv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInvInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).DeleteInv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_DeleteInv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).DeleteInv(ctx, req.(*DeleteInvInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_WarehouseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).WarehouseList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_WarehouseList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).WarehouseList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarehouseInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_CreateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).CreateWarehouse(ctx, req.(*WarehouseInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_UpdateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarehouseInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).UpdateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_UpdateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).UpdateWarehouse(ctx, req.(*WarehouseInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_SetSafeStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SafeStocksInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).SetSafeStocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_SetSafeStocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).SetSafeStocks(ctx, req.(*SafeStocksInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Inventory_LowStockList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LowStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServer).LowStockList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Inventory_LowStockList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServer).LowStockList(ctx, req.(*LowStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Inventory_ServiceDesc is the grpc.ServiceDesc for Inventory service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -232,6 +440,30 @@ var Inventory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Reback",
 			Handler:    _Inventory_Reback_Handler,
 		},
+		{
+			MethodName: "DeleteInv",
+			Handler:    _Inventory_DeleteInv_Handler,
+		},
+		{
+			MethodName: "WarehouseList",
+			Handler:    _Inventory_WarehouseList_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _Inventory_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "UpdateWarehouse",
+			Handler:    _Inventory_UpdateWarehouse_Handler,
+		},
+		{
+			MethodName: "SetSafeStocks",
+			Handler:    _Inventory_SetSafeStocks_Handler,
+		},
+		{
+			MethodName: "LowStockList",
+			Handler:    _Inventory_LowStockList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory.proto",
@@ -0,0 +1,105 @@
+package stock
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"mxshop_srvs/inventory_srv/model"
+)
+
+// pessimistic Mysql 悲观锁版本
+// select ... for update 锁住库存记录直到事务提交，同一件商品的扣减完全串行
+type pessimistic struct {
+	db *gorm.DB
+}
+
+func NewPessimistic(db *gorm.DB) Strategy {
+	return &pessimistic{db: db}
+}
+
+func (p *pessimistic) Sell(ctx context.Context, items []*Item, record func(tx *gorm.DB) error) error {
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			// 锁住这件商品所有仓库的库存
+			invs, err := findInventory(tx.Clauses(clause.Locking{Strength: "UPDATE"}), item.GoodsId)
+			if err != nil {
+				return err
+			}
+			if err = deduct(tx, invs, item); err != nil {
+				return err
+			}
+		}
+		return record(tx)
+	})
+}
+
+// maxOptimisticRetries 乐观锁冲突的最大重试次数，超过之后返回错误让调用方重试
+const maxOptimisticRetries = 100
+
+// optimistic Mysql 乐观锁版本
+// 利用版本号机制来实现乐观锁：update inventory set stocks = stocks-1, version=version+1 where goods=goods and version=version
+type optimistic struct {
+	db *gorm.DB
+}
+
+func NewOptimistic(db *gorm.DB) Strategy {
+	return &optimistic{db: db}
+}
+
+func (o *optimistic) Sell(ctx context.Context, items []*Item, record func(tx *gorm.DB) error) error {
+	return o.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			if err := o.deduct(ctx, tx, item); err != nil {
+				return err
+			}
+		}
+		return record(tx)
+	})
+}
+
+func (o *optimistic) deduct(ctx context.Context, tx *gorm.DB, item *Item) error {
+	for i := 0; i < maxOptimisticRetries; i++ {
+		// 查询不能使用事务，可重复读的事务中每次都会读到同样的版本号
+		invs, err := findInventory(o.db.WithContext(ctx), item.GoodsId)
+		if err != nil {
+			return err
+		}
+		if len(invs) == 0 {
+			return ErrNotFound
+		}
+		inv := pick(invs, item)
+		if inv == nil {
+			return ErrNotEnough
+		}
+		result := tx.Model(&model.Inventory{}).Where("id = ? and version = ? and stocks >= ?", inv.ID, inv.Version, item.Num).
+			Updates(map[string]interface{}{
+				"stocks":  gorm.Expr("stocks - ?", item.Num),
+				"version": inv.Version + 1,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 1 {
+			item.Warehouse = inv.Warehouse
+			return nil
+		}
+		// 版本号已经被其他请求修改了，重新查询之后再试
+	}
+	return status.Errorf(codes.Aborted, "库存扣减冲突，请重试")
+}
+
+// pick 按照候选仓库的顺序找到第一个库存充足的仓库
+func pick(invs []model.Inventory, item *Item) *model.Inventory {
+	for _, warehouse := range item.Warehouses {
+		for i := range invs {
+			if invs[i].Warehouse == warehouse && invs[i].Stocks >= item.Num {
+				return &invs[i]
+			}
+		}
+	}
+	return nil
+}
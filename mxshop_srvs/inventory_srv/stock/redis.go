@@ -0,0 +1,201 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	goredislib "github.com/go-redis/redis/v8"
+	"github.com/go-redsync/redsync/v4"
+	"github.com/go-redsync/redsync/v4/redis/goredis/v8"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/inventory_srv/proto"
+)
+
+// redsyncStrategy Redis 分布式锁
+// 每件商品一把锁，锁要等事务提交之后才能释放，否则其他请求会读到还没有提交的库存
+type redsyncStrategy struct {
+	db *gorm.DB
+	rs *redsync.Redsync
+}
+
+func NewRedsync(db *gorm.DB, rdb *goredislib.Client) Strategy {
+	return &redsyncStrategy{db: db, rs: redsync.New(goredis.NewPool(rdb))}
+}
+
+func (r *redsyncStrategy) Sell(ctx context.Context, items []*Item, record func(tx *gorm.DB) error) error {
+	// items按照商品id排序，多个订单按照同样的顺序加锁不会互相等待
+	var mutexes []*redsync.Mutex
+	defer func() {
+		for _, mutex := range mutexes {
+			_, _ = mutex.Unlock()
+		}
+	}()
+	for _, item := range items {
+		mutex := r.rs.NewMutex(fmt.Sprintf("goods_%d", item.GoodsId))
+		if err := mutex.LockContext(ctx); err != nil {
+			return status.Errorf(codes.Internal, "获取redis分布式锁异常")
+		}
+		mutexes = append(mutexes, mutex)
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			invs, err := findInventory(tx, item.GoodsId)
+			if err != nil {
+				return err
+			}
+			if err = deduct(tx, invs, item); err != nil {
+				return err
+			}
+		}
+		return record(tx)
+	})
+}
+
+// luaStrategy Redis 预扣减
+// 每件商品所有仓库的总库存在redis中保存一份，lua脚本原子的检查并扣减所有商品，库存不足的请求不会访问数据库
+// 预扣减成功之后再扣减数据库，数据库扣减失败会把预扣减的库存加回去
+type luaStrategy struct {
+	db  *gorm.DB
+	rdb *goredislib.Client
+}
+
+func NewLua(db *gorm.DB, rdb *goredislib.Client) Strategy {
+	return &luaStrategy{db: db, rdb: rdb}
+}
+
+// 返回1表示扣减成功，0表示库存不足，-i表示第i个商品的库存还没有加载到redis
+var preDeductScript = goredislib.NewScript(`
+for i = 1, #KEYS do
+	local stocks = redis.call('GET', KEYS[i])
+	if not stocks then
+		return -i
+	end
+	if tonumber(stocks) < tonumber(ARGV[i]) then
+		return 0
+	end
+end
+for i = 1, #KEYS do
+	redis.call('DECRBY', KEYS[i], ARGV[i])
+end
+return 1
+`)
+
+// 只给已经加载过的库存增加，没有加载的下次预扣减时会从数据库加载
+var addScript = goredislib.NewScript(`
+for i = 1, #KEYS do
+	if redis.call('EXISTS', KEYS[i]) == 1 then
+		redis.call('INCRBY', KEYS[i], ARGV[i])
+	end
+end
+return 1
+`)
+
+func stockKey(goodsId int32) string {
+	return fmt.Sprintf("inventory:stocks:%d", goodsId)
+}
+
+func (l *luaStrategy) Sell(ctx context.Context, items []*Item, record func(tx *gorm.DB) error) error {
+	goodsInfo := make([]*proto.GoodsInvInfo, 0, len(items))
+	for _, item := range items {
+		goodsInfo = append(goodsInfo, &proto.GoodsInvInfo{GoodsId: item.GoodsId, Num: item.Num})
+	}
+	if err := l.preDeduct(ctx, goodsInfo); err != nil {
+		return err
+	}
+
+	// 总库存充足不代表有一个仓库库存充足，数据库扣减失败时同样要归还预扣减的库存
+	err := l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range items {
+			invs, err := findInventory(tx, item.GoodsId)
+			if err != nil {
+				return err
+			}
+			if err = deduct(tx, invs, item); err != nil {
+				return err
+			}
+		}
+		return record(tx)
+	})
+	if err != nil {
+		// 请求被取消之后也需要把预扣减的库存加回去
+		l.Add(context.Background(), goodsInfo)
+	}
+	return err
+}
+
+func (l *luaStrategy) preDeduct(ctx context.Context, goodsInfo []*proto.GoodsInvInfo) error {
+	keys := make([]string, 0, len(goodsInfo))
+	args := make([]interface{}, 0, len(goodsInfo))
+	for _, goodInfo := range goodsInfo {
+		keys = append(keys, stockKey(goodInfo.GoodsId))
+		args = append(args, goodInfo.Num)
+	}
+
+	// 每次最多加载一件商品的库存，所以最多执行商品数量+1次
+	for i := 0; i <= len(goodsInfo); i++ {
+		ret, err := preDeductScript.Run(ctx, l.rdb, keys, args...).Int()
+		if err != nil {
+			zap.S().Errorf("[lua] 预扣减库存失败: %s", err.Error())
+			return status.Errorf(codes.Internal, "预扣减库存失败")
+		}
+		switch {
+		case ret == 1:
+			return nil
+		case ret == 0:
+			return ErrNotEnough
+		}
+		if err = l.load(ctx, goodsInfo[-ret-1].GoodsId); err != nil {
+			return err
+		}
+	}
+	return status.Errorf(codes.Internal, "预扣减库存失败")
+}
+
+// load 从数据库加载总库存到redis，已经加载过的不会覆盖
+func (l *luaStrategy) load(ctx context.Context, goodsId int32) error {
+	invs, err := findInventory(l.db.WithContext(ctx), goodsId)
+	if err != nil {
+		return status.Errorf(codes.Internal, "加载库存失败")
+	}
+	if len(invs) == 0 {
+		return ErrNotFound
+	}
+	var total int32
+	for _, inv := range invs {
+		total += inv.Stocks
+	}
+	if err = l.rdb.SetNX(ctx, stockKey(goodsId), total, 0).Err(); err != nil {
+		return status.Errorf(codes.Internal, "加载库存失败")
+	}
+	return nil
+}
+
+func (l *luaStrategy) Add(ctx context.Context, goodsInfo []*proto.GoodsInvInfo) {
+	if len(goodsInfo) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(goodsInfo))
+	args := make([]interface{}, 0, len(goodsInfo))
+	for _, goodInfo := range goodsInfo {
+		keys = append(keys, stockKey(goodInfo.GoodsId))
+		args = append(args, goodInfo.Num)
+	}
+	if err := addScript.Run(ctx, l.rdb, keys, args...).Err(); err != nil {
+		zap.S().Errorf("[lua] 归还预扣减的库存失败: %s", err.Error())
+		// 删除之后下次预扣减会重新从数据库加载
+		l.rdb.Del(ctx, keys...)
+	}
+}
+
+func (l *luaStrategy) Set(ctx context.Context, goodsId, total int32) {
+	if err := l.rdb.Set(ctx, stockKey(goodsId), strconv.Itoa(int(total)), 0).Err(); err != nil {
+		zap.S().Errorf("[lua] 设置库存失败: %s", err.Error())
+		l.rdb.Del(ctx, stockKey(goodsId))
+	}
+}
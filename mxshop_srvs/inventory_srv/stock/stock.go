@@ -0,0 +1,117 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	goredislib "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/inventory_srv/model"
+	"mxshop_srvs/inventory_srv/proto"
+)
+
+/*
+	库存扣减的策略，通过配置选择：
+		pessimistic 数据库悲观锁 select ... for update
+		optimistic  数据库乐观锁，基于版本号重试
+		redsync     redis分布式锁
+		lua         redis lua脚本原子预扣减，预扣减成功之后再扣减数据库
+	不管哪种策略最终都以数据库为准，数据库的扣减都带上 stocks >= num 的条件，不同策略混用也不会超卖
+	每件商品只从一个仓库发货，按照候选仓库的顺序选择第一个库存充足的仓库
+*/
+
+const (
+	Pessimistic = "pessimistic"
+	Optimistic  = "optimistic"
+	Redsync     = "redsync"
+	Lua         = "lua"
+)
+
+var (
+	ErrNotFound  = status.Errorf(codes.InvalidArgument, "没有库存信息")
+	ErrNotEnough = status.Errorf(codes.ResourceExhausted, "库存不足")
+)
+
+// Item 一件商品的扣减
+type Item struct {
+	GoodsId    int32
+	Num        int32
+	Warehouses []int32 // 按照分配策略排好序的候选仓库
+	Warehouse  int32   // 扣减成功之后填入发货的仓库
+}
+
+// Strategy 库存扣减策略
+type Strategy interface {
+	// Sell 在一个事务中扣减所有商品的库存，items已经合并并按商品id排序
+	// record在同一个事务中执行，用来写入扣减记录
+	Sell(ctx context.Context, items []*Item, record func(tx *gorm.DB) error) error
+}
+
+// Cache 在数据库之外还保存了库存的策略需要实现，归还和设置库存之后同步
+// 缓存的是每件商品所有仓库的总库存
+type Cache interface {
+	// Add 归还的事务提交之后增加库存
+	Add(ctx context.Context, goodsInfo []*proto.GoodsInvInfo)
+	// Set 设置库存之后覆盖缓存的库存
+	Set(ctx context.Context, goodsId, total int32)
+}
+
+// New 根据名称创建扣减策略，name为空使用redsync
+func New(name string, db *gorm.DB, rdb *goredislib.Client) (Strategy, error) {
+	switch name {
+	case Pessimistic:
+		return NewPessimistic(db), nil
+	case Optimistic:
+		return NewOptimistic(db), nil
+	case Redsync, "":
+		return NewRedsync(db, rdb), nil
+	case Lua:
+		return NewLua(db, rdb), nil
+	default:
+		return nil, fmt.Errorf("不支持的库存扣减策略: %q", name)
+	}
+}
+
+// deduct 按照候选仓库的顺序，从第一个库存充足的仓库扣减
+// invs是查询到的这件商品所有仓库的库存，扣减时带上库存充足的条件，同时增加版本号让乐观锁感知到修改
+func deduct(tx *gorm.DB, invs []model.Inventory, item *Item) error {
+	if len(invs) == 0 {
+		return ErrNotFound
+	}
+	stocks := make(map[int32]int32, len(invs))
+	for _, inv := range invs {
+		stocks[inv.Warehouse] = inv.Stocks
+	}
+	for _, warehouse := range item.Warehouses {
+		//判断库存是否充足
+		if num, ok := stocks[warehouse]; !ok || num < item.Num {
+			continue
+		}
+		result := tx.Model(&model.Inventory{}).
+			Where("goods = ? and warehouse = ? and stocks >= ?", item.GoodsId, warehouse, item.Num).
+			Updates(map[string]interface{}{
+				"stocks":  gorm.Expr("stocks - ?", item.Num),
+				"version": gorm.Expr("version + 1"),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 1 {
+			item.Warehouse = warehouse
+			return nil
+		}
+	}
+	return ErrNotEnough
+}
+
+// findInventory 查询一件商品所有仓库的库存
+func findInventory(db *gorm.DB, goodsId int32) ([]model.Inventory, error) {
+	var invs []model.Inventory
+	if result := db.Where(&model.Inventory{Goods: goodsId}).Find(&invs); result.Error != nil {
+		return nil, result.Error
+	}
+	return invs, nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mxshop_srvs/inventory_srv/alert"
+	"mxshop_srvs/inventory_srv/global"
+	"mxshop_srvs/inventory_srv/handler"
+	"mxshop_srvs/inventory_srv/initialize"
+	"mxshop_srvs/inventory_srv/proto"
+	"mxshop_srvs/inventory_srv/stock"
+)
+
+/*
+	低库存告警的测试，直接调用handler，依赖本地的mysql
+	库存10件，安全库存5件
+*/
+
+const testGoods = 999930
+
+var (
+	invServer = &handler.InventoryServer{}
+	notifier  = &alert.MemoryNotifier{}
+)
+
+func Init() {
+	initialize.InitLogger()
+	global.Strategy = stock.NewPessimistic(global.DB)
+	global.Notifier = notifier
+	if _, err := invServer.SetInv(context.Background(), &proto.GoodsInvInfo{GoodsId: testGoods, Num: 10}); err != nil {
+		panic(err)
+	}
+	if _, err := invServer.SetSafeStocks(context.Background(), &proto.SafeStocksInfo{GoodsId: testGoods, SafeStocks: 5}); err != nil {
+		panic(err)
+	}
+}
+
+func sell(num int32) {
+	if _, err := invServer.Sell(context.Background(), &proto.SellInfo{
+		OrderSn:   fmt.Sprintf("alert%d", time.Now().UnixNano()),
+		GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: testGoods, Num: num}},
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func assertEvents(want int) {
+	if got := len(notifier.Events()); got != want {
+		panic(fmt.Sprintf("应该有%d条告警，实际有%d条", want, got))
+	}
+}
+
+// TestCrossThreshold 库存低于安全库存时告警一次，之后继续扣减不会重复告警
+func TestCrossThreshold() {
+	sell(5)
+	assertEvents(0)
+	sell(1)
+	assertEvents(1)
+	if event := notifier.Events()[0]; event.Stocks != 4 || event.SafeStocks != 5 {
+		panic(fmt.Sprintf("告警的库存不正确: %+v", event))
+	}
+	sell(1)
+	assertEvents(1)
+	fmt.Println("低于安全库存时告警了一次")
+}
+
+// TestLowStockList 低于安全库存的商品出现在列表中
+func TestLowStockList() {
+	rsp, err := invServer.LowStockList(context.Background(), &proto.LowStockRequest{PagePerNums: 100})
+	if err != nil {
+		panic(err)
+	}
+	for _, inv := range rsp.Data {
+		if inv.GoodsId == testGoods {
+			fmt.Printf("低库存商品共%d件\n", rsp.Total)
+			return
+		}
+	}
+	panic("低库存列表中没有测试商品")
+}
+
+func main() {
+	Init()
+	TestCrossThreshold()
+	TestLowStockList()
+}
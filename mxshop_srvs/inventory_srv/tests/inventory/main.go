@@ -48,6 +48,7 @@ func TestSell() {
 		2. 两件都扣减成功
 	*/
 	_, err := invClient.Sell(context.Background(), &proto.SellInfo{
+		OrderSn: "test-order-1",
 		GoodsInfo: []*proto.GoodsInvInfo{
 			{GoodsId: 1, Num: 1},
 			{GoodsId: 2, Num: 70},
@@ -59,8 +60,10 @@ func TestSell() {
 	fmt.Println("库存扣减成功")
 }
 
+// TestReback 只会归还订单实际扣减过的库存，请求中的数量会被忽略
 func TestReback() {
 	_, err := invClient.Reback(context.Background(), &proto.SellInfo{
+		OrderSn: "test-order-1",
 		GoodsInfo: []*proto.GoodsInvInfo{
 			{GoodsId: 1, Num: 10},
 			{GoodsId: 100, Num: 30},
@@ -72,6 +75,19 @@ func TestReback() {
 	fmt.Println("归还成功")
 }
 
+// TestDeleteInv 删除之后查询不到库存，同样的商品id可以重新设置库存
+func TestDeleteInv(goodsId int32) {
+	TestSetInv(goodsId, 10)
+	if _, err := invClient.DeleteInv(context.Background(), &proto.DeleteInvInfo{GoodsIds: []int32{goodsId}}); err != nil {
+		panic(err)
+	}
+	if _, err := invClient.InvDetail(context.Background(), &proto.GoodsInvInfo{GoodsId: goodsId}); err == nil {
+		panic("删除之后不应该查询到库存")
+	}
+	TestSetInv(goodsId, 10)
+	fmt.Println("删除库存成功")
+}
+
 func main() {
 	Init()
 	for i := 421; i <= 840; i++ {
@@ -81,5 +97,6 @@ func main() {
 	//TestInvDetail(2)
 	//TestSell()
 	//TestReback()
+	//TestDeleteInv(9990)
 	conn.Close()
 }
\ No newline at end of file
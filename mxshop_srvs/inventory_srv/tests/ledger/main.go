@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_srvs/inventory_srv/global"
+	"mxshop_srvs/inventory_srv/handler"
+	"mxshop_srvs/inventory_srv/initialize"
+	"mxshop_srvs/inventory_srv/model"
+	"mxshop_srvs/inventory_srv/proto"
+	"mxshop_srvs/inventory_srv/stock"
+)
+
+/*
+	库存扣减记录的测试，直接调用handler，依赖本地的mysql
+*/
+
+const testGoods = 999901
+
+var invServer = &handler.InventoryServer{}
+
+func Init() {
+	initialize.InitLogger()
+	global.Strategy = stock.NewPessimistic(global.DB)
+	if _, err := invServer.SetInv(context.Background(), &proto.GoodsInvInfo{GoodsId: testGoods, Num: 100}); err != nil {
+		panic(err)
+	}
+}
+
+func orderSn(name string) string {
+	return fmt.Sprintf("%s%d", name, time.Now().UnixNano())
+}
+
+func stocks() int32 {
+	var inv model.Inventory
+	global.DB.Where(&model.Inventory{Goods: testGoods}).First(&inv)
+	return inv.Stocks
+}
+
+func sell(orderSn string, num int32) error {
+	_, err := invServer.Sell(context.Background(), &proto.SellInfo{
+		OrderSn:   orderSn,
+		GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: testGoods, Num: num}},
+	})
+	return err
+}
+
+func reback(orderSn string, num int32) error {
+	_, err := invServer.Reback(context.Background(), &proto.SellInfo{
+		OrderSn:   orderSn,
+		GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: testGoods, Num: num}},
+	})
+	return err
+}
+
+func assertStocks(want int32) {
+	if got := stocks(); got != want {
+		panic(fmt.Sprintf("库存应该是%d，实际是%d", want, got))
+	}
+}
+
+// TestSellTwice 同一个订单重复扣减只扣减一次
+func TestSellTwice() {
+	before := stocks()
+	sn := orderSn("sell")
+	for i := 0; i < 2; i++ {
+		if err := sell(sn, 3); err != nil {
+			panic(err)
+		}
+	}
+	assertStocks(before - 3)
+	fmt.Println("重复扣减只扣减了一次")
+}
+
+// TestRebackTwice 重复归还只归还一次，并且只归还订单实际扣减的数量
+func TestRebackTwice() {
+	before := stocks()
+	sn := orderSn("reback")
+	if err := sell(sn, 2); err != nil {
+		panic(err)
+	}
+	for i := 0; i < 2; i++ {
+		// 请求中的数量和扣减的不一致，以扣减记录为准
+		if err := reback(sn, 50); err != nil {
+			panic(err)
+		}
+	}
+	assertStocks(before)
+	fmt.Println("重复归还只归还了一次")
+}
+
+// TestRebackBeforeSell 没有扣减过的订单归还不会增加库存，之后到达的扣减也不会再扣减
+func TestRebackBeforeSell() {
+	before := stocks()
+	sn := orderSn("hang")
+	if err := reback(sn, 5); err != nil {
+		panic(err)
+	}
+	assertStocks(before)
+	if err := sell(sn, 5); status.Code(err) != codes.FailedPrecondition {
+		panic(fmt.Sprintf("已经归还的订单扣减应该返回FailedPrecondition，实际返回%v", err))
+	}
+	assertStocks(before)
+	fmt.Println("归还之后到达的扣减被拒绝")
+}
+
+func main() {
+	Init()
+	TestSellTwice()
+	TestRebackTwice()
+	TestRebackBeforeSell()
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	goredislib "github.com/go-redis/redis/v8"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_srvs/inventory_srv/global"
+	"mxshop_srvs/inventory_srv/handler"
+	"mxshop_srvs/inventory_srv/initialize"
+	"mxshop_srvs/inventory_srv/model"
+	"mxshop_srvs/inventory_srv/proto"
+	"mxshop_srvs/inventory_srv/stock"
+)
+
+/*
+	所有库存扣减策略共用的并发测试，直接调用handler，依赖本地的mysql和redis
+	每种策略都用大量并发的请求抢购少量库存，验证不会超卖：
+		1. 成功的请求数等于初始库存
+		2. 最终库存为0，扣减记录的数量等于成功的请求数
+		3. 失败的请求只能是库存不足
+*/
+
+const (
+	initStocks = 50
+	callers    = 200
+)
+
+var invServer = &handler.InventoryServer{}
+
+func Init() {
+	initialize.InitLogger()
+	global.RedisClient = goredislib.NewClient(&goredislib.Options{
+		Addr: "127.0.0.1:6379",
+	})
+}
+
+func TestNoOversell(name string, goodsId int32) {
+	strategy, err := stock.New(name, global.DB, global.RedisClient)
+	if err != nil {
+		panic(err)
+	}
+	global.Strategy = strategy
+	if _, err = invServer.SetInv(context.Background(), &proto.GoodsInvInfo{GoodsId: goodsId, Num: initStocks}); err != nil {
+		panic(err)
+	}
+
+	prefix := fmt.Sprintf("%s%d-", name, time.Now().UnixNano())
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	succeeded := 0
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, err := invServer.Sell(context.Background(), &proto.SellInfo{
+				OrderSn:   fmt.Sprintf("%s%d", prefix, i),
+				GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: goodsId, Num: 1}},
+			})
+			if err != nil {
+				if status.Code(err) != codes.ResourceExhausted {
+					panic(fmt.Sprintf("[%s] 扣减失败只能是库存不足，实际返回%v", name, err))
+				}
+				return
+			}
+			mu.Lock()
+			succeeded++
+			mu.Unlock()
+		}(i)
+	}
+	wg.Wait()
+
+	var inv model.Inventory
+	global.DB.Where(&model.Inventory{Goods: goodsId}).First(&inv)
+	var details int64
+	global.DB.Model(&model.StockSellDetail{}).Where("goods = ? and order_sn like ?", goodsId, prefix+"%").Count(&details)
+	if succeeded != initStocks || inv.Stocks != 0 || details != initStocks {
+		panic(fmt.Sprintf("[%s] 超卖了：成功%d次，剩余库存%d，扣减记录%d条", name, succeeded, inv.Stocks, details))
+	}
+	fmt.Printf("[%s] %d个请求抢购%d件库存，没有超卖\n", name, callers, initStocks)
+}
+
+func main() {
+	Init()
+	for i, name := range []string{stock.Pessimistic, stock.Optimistic, stock.Redsync, stock.Lua} {
+		TestNoOversell(name, int32(999910+i))
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"mxshop_srvs/inventory_srv/global"
+	"mxshop_srvs/inventory_srv/handler"
+	"mxshop_srvs/inventory_srv/initialize"
+	"mxshop_srvs/inventory_srv/proto"
+	"mxshop_srvs/inventory_srv/stock"
+)
+
+/*
+	多仓库的测试，直接调用handler，依赖本地的mysql
+	北京仓的优先级高于广东仓，两个仓库都有库存
+*/
+
+const testGoods = 999920
+
+var (
+	invServer = &handler.InventoryServer{}
+	beijing   *proto.WarehouseInfo
+	guangdong *proto.WarehouseInfo
+)
+
+func Init() {
+	initialize.InitLogger()
+	global.Strategy = stock.NewPessimistic(global.DB)
+
+	var err error
+	suffix := time.Now().UnixNano()
+	if beijing, err = invServer.CreateWarehouse(context.Background(), &proto.WarehouseInfo{
+		Name: fmt.Sprintf("北京仓%d", suffix), Province: "北京市", Priority: 1000,
+	}); err != nil {
+		panic(err)
+	}
+	if guangdong, err = invServer.CreateWarehouse(context.Background(), &proto.WarehouseInfo{
+		Name: fmt.Sprintf("广东仓%d", suffix), Province: "广东省", Priority: 999,
+	}); err != nil {
+		panic(err)
+	}
+	for _, warehouse := range []*proto.WarehouseInfo{beijing, guangdong} {
+		if _, err = invServer.SetInv(context.Background(), &proto.GoodsInvInfo{
+			GoodsId: testGoods, WarehouseId: warehouse.Id, Num: 10,
+		}); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func sell(province string, num int32) int32 {
+	rsp, err := invServer.Sell(context.Background(), &proto.SellInfo{
+		OrderSn:   fmt.Sprintf("warehouse%d", time.Now().UnixNano()),
+		Province:  province,
+		GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: testGoods, Num: num}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rsp.GoodsInfo[0].WarehouseId
+}
+
+func assertWarehouse(got int32, want *proto.WarehouseInfo) {
+	if got != want.Id {
+		panic(fmt.Sprintf("应该从%s发货，实际是仓库%d", want.Name, got))
+	}
+}
+
+// TestPriority 按优先级分配时从优先级最高的仓库发货
+func TestPriority() {
+	global.ServerConfig.AllocatePolicy = handler.AllocatePriority
+	assertWarehouse(sell("广东省深圳市", 1), beijing)
+	fmt.Println("按优先级从北京仓发货")
+}
+
+// TestNearest 就近分配时从收货地址所在省份的仓库发货
+func TestNearest() {
+	global.ServerConfig.AllocatePolicy = handler.AllocateNearest
+	assertWarehouse(sell("广东", 1), guangdong)
+	// 没有同省的仓库按优先级
+	assertWarehouse(sell("上海", 1), beijing)
+	fmt.Println("就近从广东仓发货")
+}
+
+// TestFallback 库存不足时从下一个仓库发货，一件商品不会拆到多个仓库
+func TestFallback() {
+	global.ServerConfig.AllocatePolicy = handler.AllocatePriority
+	assertWarehouse(sell("", 9), guangdong)
+	fmt.Println("北京仓库存不足时从广东仓发货")
+}
+
+// TestInvDetail 总库存等于各个仓库的库存之和
+func TestInvDetail() {
+	rsp, err := invServer.InvDetail(context.Background(), &proto.GoodsInvInfo{GoodsId: testGoods})
+	if err != nil {
+		panic(err)
+	}
+	var sum int32
+	for _, warehouse := range rsp.Warehouses {
+		sum += warehouse.Num
+	}
+	if sum != rsp.Num {
+		panic(fmt.Sprintf("总库存%d和各个仓库的库存之和%d不一致", rsp.Num, sum))
+	}
+	fmt.Printf("总库存%d，分布在%d个仓库\n", rsp.Num, len(rsp.Warehouses))
+}
+
+func main() {
+	Init()
+	TestPriority()
+	TestNearest()
+	TestFallback()
+	TestInvDetail()
+}
@@ -26,6 +26,13 @@ type ServerConfig struct {
 	GoodsSrvInfo SrvConfig `mapstructure:"goods_srv" json:"goods_srv"`
 	//库存微服务的配置
 	InventorySrvInfo SrvConfig `mapstructure:"inventory_srv" json:"inventory_srv"`
+	//用户微服务的配置，下单时查询收货地址
+	UserSrvInfo SrvConfig `mapstructure:"user_srv" json:"user_srv"`
+
+	//订单超时未支付自动关闭的时间，单位秒，不配置默认30分钟
+	OrderTimeout int `mapstructure:"order_timeout" json:"order_timeout"`
+	//下单幂等key的有效期，单位秒，不配置默认24小时
+	IdempotencyWindow int `mapstructure:"idempotency_window" json:"idempotency_window"`
 }
 
 type NacosConfig struct {
@@ -0,0 +1,173 @@
+package delay
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/model"
+)
+
+/*
+	基于mysql的延时任务
+		1. 任务和业务数据在同一个本地事务中写入，订单创建成功任务就一定存在
+		2. 多个副本同时轮询到期的任务，通过条件更新抢占任务，同一时刻一个任务只会被一个副本执行
+		3. 副本执行任务时崩溃，占用过期之后会被其他副本重新执行，所以任务的处理函数必须是幂等的
+*/
+
+// Handler 任务的处理函数，key是添加任务时的业务key
+type Handler func(ctx context.Context, key string) error
+
+var (
+	handlersMu sync.RWMutex
+	handlers   = map[string]Handler{}
+
+	// owner 当前副本的标识
+	owner = fmt.Sprintf("%s-%d-%s", hostname(), os.Getpid(), uuid.NewV4().String()[:8])
+)
+
+const (
+	lease      = time.Minute // 每次占用任务的时长，处理函数需要在这个时间内完成
+	batchSize  = 100
+	maxBackoff = 10 * time.Minute
+)
+
+func hostname() string {
+	name, _ := os.Hostname()
+	return name
+}
+
+// Register 注册某类任务的处理函数
+func Register(topic string, h Handler) {
+	handlersMu.Lock()
+	defer handlersMu.Unlock()
+	handlers[topic] = h
+}
+
+func getHandler(topic string) (Handler, bool) {
+	handlersMu.RLock()
+	defer handlersMu.RUnlock()
+	h, ok := handlers[topic]
+	return h, ok
+}
+
+// Add 添加一个延时任务，tx传入业务所在的事务，保证任务和业务数据一起提交
+func Add(tx *gorm.DB, topic, key string, delay time.Duration) error {
+	return tx.Create(&model.DelayJob{
+		Topic:  topic,
+		BizKey: key,
+		RunAt:  time.Now().Add(delay),
+		Status: model.JobPending,
+	}).Error
+}
+
+// Poll 执行一轮到期的任务，返回执行的任务数
+func Poll(ctx context.Context, maxAttempts int32) int {
+	now := time.Now()
+	var jobs []model.DelayJob
+	global.DB.Where("(status = ? and run_at <= ?) or (status = ? and lease_until < ?)",
+		model.JobPending, now, model.JobRunning, now).
+		Order("run_at").Limit(batchSize).Find(&jobs)
+
+	executed := 0
+	for _, job := range jobs {
+		if !claim(job) {
+			continue // 已经被其他副本抢占了
+		}
+		executed++
+		run(ctx, job, maxAttempts)
+	}
+	return executed
+}
+
+// claim 抢占任务，update的条件带上查询时的状态和执行次数，只有一个副本能更新成功
+func claim(job model.DelayJob) bool {
+	leaseUntil := time.Now().Add(lease)
+	result := global.DB.Model(&model.DelayJob{}).
+		Where("id = ? and status = ? and attempts = ?", job.ID, job.Status, job.Attempts).
+		Updates(map[string]interface{}{
+			"status":      model.JobRunning,
+			"owner":       owner,
+			"lease_until": leaseUntil,
+			"attempts":    job.Attempts + 1,
+		})
+	return result.RowsAffected == 1
+}
+
+func run(ctx context.Context, job model.DelayJob, maxAttempts int32) {
+	attempts := job.Attempts + 1
+	h, ok := getHandler(job.Topic)
+	if !ok {
+		zap.S().Errorf("[delay] 任务 %s 没有注册处理函数", job.Topic)
+		finish(job.ID, model.JobFailed, "没有注册处理函数")
+		return
+	}
+
+	err := safeCall(ctx, h, job.BizKey)
+	if err == nil {
+		finish(job.ID, model.JobDone, "")
+		return
+	}
+
+	zap.S().Errorf("[delay] 任务 %s:%s 第%d次执行失败: %s", job.Topic, job.BizKey, attempts, err.Error())
+	if attempts >= maxAttempts {
+		finish(job.ID, model.JobFailed, err.Error())
+		return
+	}
+	// 失败之后退避重试
+	backoff := time.Duration(attempts*attempts) * 10 * time.Second
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	global.DB.Model(&model.DelayJob{}).Where("id = ? and owner = ?", job.ID, owner).Updates(map[string]interface{}{
+		"status": model.JobPending,
+		"run_at": time.Now().Add(backoff),
+		"error":  truncate(err.Error()),
+	})
+}
+
+func safeCall(ctx context.Context, h Handler, key string) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return h(ctx, key)
+}
+
+func finish(id int32, status, errMsg string) {
+	global.DB.Model(&model.DelayJob{}).Where("id = ? and owner = ?", id, owner).Updates(map[string]interface{}{
+		"status": status,
+		"error":  truncate(errMsg),
+	})
+}
+
+// Run 后台定时轮询到期的任务
+func Run(interval time.Duration, maxAttempts int32) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		for {
+			// 一轮处理满了说明还有积压，继续处理
+			if Poll(context.Background(), maxAttempts) < batchSize {
+				break
+			}
+		}
+	}
+}
+
+// truncate 错误信息截断，避免超出字段长度
+func truncate(s string) string {
+	r := []rune(s)
+	if len(r) > 200 {
+		return fmt.Sprintf("%s...", string(r[:200]))
+	}
+	return s
+}
@@ -18,6 +18,7 @@ var (
 	NacosConfig        config.NacosConfig
 	GoodsSrvClient     proto.GoodsClient
 	InventorySrvClient proto.InventoryClient
+	AddressSrvClient   proto.AddressClient
 )
 
 func init() {
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/model"
+	"mxshop_srvs/order_srv/proto"
+)
+
+/*
+	下单的幂等
+		1. 下单之前先用 用户id+幂等key 占用一条记录，记录中保存这次下单的订单号，唯一索引保证只有一个请求能占用成功
+		2. 占用失败说明是重复请求：订单已经创建就返回原来的订单，还在创建中就让客户端稍后重试
+		3. 下单失败会删除记录，客户端可以用同一个key重试
+*/
+
+const (
+	maxIdempotencyKeyLen = 64
+	// idempotencyPending 超过这个时间还没有创建出订单，认为占用记录的请求已经异常退出了
+	idempotencyPending = time.Minute
+)
+
+// IdempotencyWindow 幂等key的有效期，过期之后同一个key会创建新的订单
+func IdempotencyWindow() time.Duration {
+	if global.ServerConfig.IdempotencyWindow > 0 {
+		return time.Duration(global.ServerConfig.IdempotencyWindow) * time.Second
+	}
+	return 24 * time.Hour
+}
+
+// reserveIdempotencyKey 占用幂等key，返回的订单不为空说明是重复请求
+func reserveIdempotencyKey(userId int32, key, orderSn string) (*proto.OrderInfoResponse, error) {
+	if len(key) > maxIdempotencyKeyLen {
+		return nil, status.Errorf(codes.InvalidArgument, "幂等key不能超过%d个字符", maxIdempotencyKeyLen)
+	}
+
+	// 占用的记录过期或者异常之后会被删除，删除之后重新占用一次
+	for i := 0; i < 2; i++ {
+		if result := global.DB.Create(&model.OrderIdempotency{User: userId, IdemKey: key, OrderSn: orderSn}); result.Error == nil {
+			return nil, nil
+		}
+
+		var existing model.OrderIdempotency
+		if result := global.DB.Where(&model.OrderIdempotency{User: userId, IdemKey: key}).First(&existing); result.RowsAffected == 0 {
+			continue
+		}
+		age := time.Since(existing.CreatedAt)
+		if age > IdempotencyWindow() {
+			deleteIdempotencyKey(userId, key, existing.OrderSn)
+			continue
+		}
+
+		var order model.OrderInfo
+		if result := global.DB.Where(&model.OrderInfo{OrderSn: existing.OrderSn}).First(&order); result.RowsAffected == 1 {
+			return orderInfoResponse(&order), nil
+		}
+		if age < idempotencyPending {
+			return nil, status.Errorf(codes.Aborted, "订单正在创建中，请勿重复提交")
+		}
+		deleteIdempotencyKey(userId, key, existing.OrderSn)
+	}
+	return nil, status.Errorf(codes.Internal, "创建订单失败")
+}
+
+// deleteIdempotencyKey 删除占用的记录，带上订单号避免删除其他请求重新占用的记录
+func deleteIdempotencyKey(userId int32, key, orderSn string) {
+	global.DB.Unscoped().Where(&model.OrderIdempotency{User: userId, IdemKey: key, OrderSn: orderSn}).
+		Delete(&model.OrderIdempotency{})
+}
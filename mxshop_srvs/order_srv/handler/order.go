@@ -2,15 +2,21 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"math/rand"
+	"mxshop_srvs/order_srv/delay"
 	"mxshop_srvs/order_srv/global"
 	"mxshop_srvs/order_srv/model"
 	"mxshop_srvs/order_srv/proto"
+	"mxshop_srvs/order_srv/saga"
+	"strings"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type OrderServer struct {
@@ -35,21 +41,29 @@ func (*OrderServer) CartItemList(ctx context.Context, req *proto.UserInfo) (*pro
 			GoodsId: shopCart.Goods,
 			Nums:    shopCart.Nums,
 			Checked: shopCart.Checked,
+			SkuId:   shopCart.Sku,
 		})
 	}
 	return &rsp, nil
 }
 
+// cartItem 用户购物车中的一个商品，同一个商品的不同规格是不同的记录
+// sku是0时结构体条件会忽略这个字段，所以使用sql条件
+func cartItem(tx *gorm.DB, userId, goodsId, skuId int32) *gorm.DB {
+	return tx.Where("user = ? and goods = ? and sku = ?", userId, goodsId, skuId)
+}
+
 // CreateCartItem 将商品添加到购物车
 func (*OrderServer) CreateCartItem(ctx context.Context, req *proto.CartItemRequest) (*proto.ShopCartInfoResponse, error) {
 	var shopCart model.ShoppingCart
-	result := global.DB.Where(&model.ShoppingCart{Goods: req.GoodsId, User: req.UserId}).First(&shopCart)
+	result := cartItem(global.DB, req.UserId, req.GoodsId, req.SkuId).First(&shopCart)
 	if result.RowsAffected == 1 {
 		// 如果记录已经存在，则直接合并购物车记录
 		shopCart.Nums += req.Nums
 	} else {
 		shopCart.User = req.UserId
 		shopCart.Goods = req.GoodsId
+		shopCart.Sku = req.SkuId
 		shopCart.Nums = req.Nums
 		shopCart.Checked = false
 	}
@@ -59,8 +73,13 @@ func (*OrderServer) CreateCartItem(ctx context.Context, req *proto.CartItemReque
 
 // UpdateCartItem 更新购物车记录
 func (*OrderServer) UpdateCartItem(ctx context.Context, req *proto.CartItemRequest) (*emptypb.Empty, error) {
+	// 没有传记录id时按照用户、商品和规格查找
 	var shopCart model.ShoppingCart
-	if result := global.DB.First(&shopCart, req.Id); result.RowsAffected == 0 {
+	query := global.DB.Where("id = ?", req.Id)
+	if req.Id == 0 {
+		query = cartItem(global.DB, req.UserId, req.GoodsId, req.SkuId)
+	}
+	if result := query.First(&shopCart); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.NotFound, "记录不存在")
 	}
 	shopCart.Checked = req.Checked
@@ -74,7 +93,7 @@ func (*OrderServer) UpdateCartItem(ctx context.Context, req *proto.CartItemReque
 
 // DeleteCartItem 删除购物车记录
 func (*OrderServer) DeleteCartItem(ctx context.Context, req *proto.CartItemRequest) (*emptypb.Empty, error) {
-	if result := global.DB.Where("goods=? and user=?", req.GoodsId, req.UserId).Delete(&model.ShoppingCart{}); result.RowsAffected == 0 {
+	if result := cartItem(global.DB, req.UserId, req.GoodsId, req.SkuId).Delete(&model.ShoppingCart{}); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.NotFound, "购物车记录不存在")
 	}
 	return &emptypb.Empty{}, nil
@@ -94,17 +113,21 @@ func (*OrderServer) OrderList(ctx context.Context, req *proto.OrderFilterRequest
 
 	for _, order := range orders {
 		rsp.Data = append(rsp.Data, &proto.OrderInfoResponse{
-			Id:      order.ID,
-			UserId:  order.User,
-			OrderSn: order.OrderSn,
-			PayType: order.PayType,
-			Status:  order.Status,
-			Post:    order.Post,
-			Total:   order.OrderMount,
-			Address: order.Address,
-			Name:    order.SignerName,
-			Mobile:  order.SingerMobile,
-			AddTime: order.CreatedAt.Format("2006-01-02 15:04:05"),
+			Id:       order.ID,
+			UserId:   order.User,
+			OrderSn:  order.OrderSn,
+			PayType:  order.PayType,
+			Status:   order.Status,
+			State:    orderState(order.Status),
+			Post:     order.Post,
+			Total:    order.OrderMount,
+			Address:  order.Address,
+			Name:     order.SignerName,
+			Mobile:   order.SingerMobile,
+			AddTime:  order.CreatedAt.Format("2006-01-02 15:04:05"),
+			Province: order.Province,
+			City:     order.City,
+			District: order.District,
 		})
 	}
 	return &rsp, nil
@@ -116,7 +139,11 @@ func (*OrderServer) OrderDetail(ctx context.Context, req *proto.OrderRequest) (*
 	var rsp proto.OrderInfoDetailResponse
 	//这个订单的id是否是当前用户的订单， 如果在web层用户传递过来一个id的订单， web层应该先查询一下订单id是否是当前用户的
 	//在个人中心可以这样做，但是如果是后台管理系统，web层如果是后台管理系统 那么只传递order的id，如果是电商系统还需要一个用户的id
-	if result := global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: req.Id}, User: req.UserId}).First(&order); result.RowsAffected == 0 {
+	// 零值的条件会被gorm忽略，id和订单号至少要有一个
+	if req.Id <= 0 && req.OrderSn == "" {
+		return nil, status.Errorf(codes.NotFound, "订单不存在")
+	}
+	if result := global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: req.Id}, User: req.UserId, OrderSn: req.OrderSn}).First(&order); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.NotFound, "订单不存在")
 	}
 	orderInfo := proto.OrderInfoResponse{}
@@ -125,11 +152,15 @@ func (*OrderServer) OrderDetail(ctx context.Context, req *proto.OrderRequest) (*
 	orderInfo.OrderSn = order.OrderSn
 	orderInfo.PayType = order.PayType
 	orderInfo.Status = order.Status
+	orderInfo.State = orderState(order.Status)
 	orderInfo.Post = order.Post
 	orderInfo.Total = order.OrderMount
 	orderInfo.Address = order.Address
 	orderInfo.Name = order.SignerName
 	orderInfo.Mobile = order.SingerMobile
+	orderInfo.Province = order.Province
+	orderInfo.City = order.City
+	orderInfo.District = order.District
 	rsp.OrderInfo = &orderInfo
 
 	var orderGoods []model.OrderGoods
@@ -138,17 +169,84 @@ func (*OrderServer) OrderDetail(ctx context.Context, req *proto.OrderRequest) (*
 	}
 	for _, orderGood := range orderGoods {
 		rsp.Goods = append(rsp.Goods, &proto.OrderItemResponse{
-			GoodsId:    orderGood.Goods,
-			GoodsName:  orderGood.GoodsName,
-			GoodsPrice: orderGood.GoodsPrice,
-			GoodsImage: orderGood.GoodsImage,
-			Nums:       orderGood.Nums,
+			Id:          orderGood.ID,
+			OrderId:     orderGood.Order,
+			GoodsId:     orderGood.Goods,
+			GoodsName:   orderGood.GoodsName,
+			GoodsPrice:  orderGood.GoodsPrice,
+			GoodsImage:  orderGood.GoodsImage,
+			Nums:        orderGood.Nums,
+			WarehouseId: orderGood.Warehouse,
+			SkuId:       orderGood.Sku,
+			SkuSpecs:    orderGood.SkuSpecs,
+		})
+	}
+
+	var histories []model.OrderStatusHistory
+	if result := global.DB.Where(&model.OrderStatusHistory{Order: order.ID}).Order("id").Find(&histories); result.Error != nil {
+		return nil, result.Error
+	}
+	for _, history := range histories {
+		rsp.History = append(rsp.History, &proto.OrderStatusHistoryResponse{
+			From:    orderState(history.FromStatus),
+			To:      orderState(history.ToStatus),
+			Remark:  history.Remark,
+			AddTime: history.CreatedAt.Format("2006-01-02 15:04:05"),
 		})
 	}
 	return &rsp, nil
 }
 
+// OrderGoodsDetail 查询用户购买的一件商品和订单的状态，商品服务评价商品前通过这个接口检查购买记录
+func (*OrderServer) OrderGoodsDetail(ctx context.Context, req *proto.OrderGoodsRequest) (*proto.OrderGoodsResponse, error) {
+	// 零值的条件会被gorm忽略，id和用户都必须有
+	if req.Id <= 0 || req.UserId <= 0 {
+		return nil, status.Errorf(codes.NotFound, "订单商品不存在")
+	}
+	var orderGood model.OrderGoods
+	if result := global.DB.First(&orderGood, req.Id); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "订单商品不存在")
+	}
+	var order model.OrderInfo
+	if result := global.DB.Where(&model.OrderInfo{BaseModel: model.BaseModel{ID: orderGood.Order}, User: req.UserId}).First(&order); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "订单商品不存在")
+	}
+	return &proto.OrderGoodsResponse{
+		Goods: &proto.OrderItemResponse{
+			Id:          orderGood.ID,
+			OrderId:     orderGood.Order,
+			GoodsId:     orderGood.Goods,
+			GoodsName:   orderGood.GoodsName,
+			GoodsPrice:  orderGood.GoodsPrice,
+			GoodsImage:  orderGood.GoodsImage,
+			Nums:        orderGood.Nums,
+			WarehouseId: orderGood.Warehouse,
+			SkuId:       orderGood.Sku,
+			SkuSpecs:    orderGood.SkuSpecs,
+		},
+		UserId: order.User,
+		Status: orderState(order.Status),
+	}, nil
+}
+
+// CreateOrder 新建订单，请求带上幂等key时同一个key只会创建一个订单
 func (*OrderServer) CreateOrder(ctx context.Context, req *proto.OrderRequest) (*proto.OrderInfoResponse, error) {
+	orderSn := GenerateOrderSn(req.UserId)
+	if req.IdempotencyKey == "" {
+		return createOrder(ctx, req, orderSn)
+	}
+
+	if rsp, err := reserveIdempotencyKey(req.UserId, req.IdempotencyKey, orderSn); err != nil || rsp != nil {
+		return rsp, err
+	}
+	rsp, err := createOrder(ctx, req, orderSn)
+	if err != nil {
+		deleteIdempotencyKey(req.UserId, req.IdempotencyKey, orderSn)
+	}
+	return rsp, err
+}
+
+func createOrder(ctx context.Context, req *proto.OrderRequest, orderSn string) (*proto.OrderInfoResponse, error) {
 	/*
 		新建订单
 			1. 从购物车中获取到选中的商品
@@ -157,9 +255,13 @@ func (*OrderServer) CreateOrder(ctx context.Context, req *proto.OrderRequest) (*
 			4. 订单的基本信息表 - 订单的商品信息表
 			5. 从购物车中删除已购买的记录
 	*/
-	var goodsId []int32
+	order, err := orderAddress(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var goodsId, skuId []int32
 	var shopCarts []model.ShoppingCart
-	goodsNumsMap := make(map[int32]int32)
 
 	if result := global.DB.Where(&model.ShoppingCart{User: req.UserId, Checked: true}).Find(&shopCarts); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "没有选中结算的商品")
@@ -167,7 +269,9 @@ func (*OrderServer) CreateOrder(ctx context.Context, req *proto.OrderRequest) (*
 
 	for _, shopCart := range shopCarts {
 		goodsId = append(goodsId, shopCart.Goods)
-		goodsNumsMap[shopCart.Goods] = shopCart.Nums
+		if shopCart.Sku > 0 {
+			skuId = append(skuId, shopCart.Sku)
+		}
 	}
 
 	//跨服务调用 - 商品微服务 —— 批量查询商品信息
@@ -175,63 +279,172 @@ func (*OrderServer) CreateOrder(ctx context.Context, req *proto.OrderRequest) (*
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "批量查询商品信息失败")
 	}
+	goodsMap := make(map[int32]*proto.GoodsInfoResponse, len(goods.Data))
+	for _, good := range goods.Data {
+		goodsMap[good.Id] = good
+	}
+	// 有规格的商品使用SKU的价格、图片和库存
+	skuMap := make(map[int32]*proto.SkuInfo)
+	if len(skuId) > 0 {
+		skus, err := global.GoodsSrvClient.BatchGetSku(context.Background(), &proto.BatchSkuIdInfo{Id: skuId})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "批量查询商品规格失败")
+		}
+		for _, sku := range skus.Data {
+			skuMap[sku.Id] = sku
+		}
+	}
 
 	var orderAmount float32
 	var orderGoods []*model.OrderGoods
 	var goodsInvInfo []*proto.GoodsInvInfo
-	for _, good := range goods.Data {
-		orderAmount += good.ShopPrice * float32(goodsNumsMap[good.Id])
-		orderGoods = append(orderGoods, &model.OrderGoods{
-			Goods:      good.Id,
-			GoodsName:  good.Name,
-			GoodsImage: good.GoodsFrontImage,
-			GoodsPrice: good.ShopPrice,
-			Nums:       goodsNumsMap[good.Id],
-		})
+	for _, shopCart := range shopCarts {
+		good, ok := goodsMap[shopCart.Goods]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "商品不存在")
+		}
+		orderGood := &model.OrderGoods{
+			Goods:        good.Id,
+			GoodsName:    good.Name,
+			GoodsImage:   good.GoodsFrontImage,
+			GoodsPrice:   good.ShopPrice,
+			Nums:         shopCart.Nums,
+			InventoryKey: good.Id,
+		}
+		if shopCart.Sku > 0 {
+			sku, ok := skuMap[shopCart.Sku]
+			if !ok || sku.GoodsId != good.Id {
+				return nil, status.Errorf(codes.InvalidArgument, "商品规格不存在")
+			}
+			if !sku.OnSale {
+				return nil, status.Errorf(codes.InvalidArgument, "商品规格已下架")
+			}
+			orderGood.Sku = sku.Id
+			orderGood.SkuSpecs = skuSpecs(sku)
+			orderGood.GoodsPrice = sku.ShopPrice
+			orderGood.InventoryKey = sku.InventoryKey
+			if sku.Image != "" {
+				orderGood.GoodsImage = sku.Image
+			}
+		}
+		orderAmount += orderGood.GoodsPrice * float32(orderGood.Nums)
+		orderGoods = append(orderGoods, orderGood)
 		goodsInvInfo = append(goodsInvInfo, &proto.GoodsInvInfo{
-			GoodsId: good.Id,
-			Num:     goodsNumsMap[good.Id],
+			GoodsId: orderGood.InventoryKey,
+			Num:     orderGood.Nums,
 		})
 	}
 
 	//跨服务调用 - 库存微服务 —— 扣减库存
-	if _, err = global.InventorySrvClient.Sell(context.Background(), &proto.SellInfo{GoodsInfo: goodsInvInfo}); err != nil {
-		return nil, status.Errorf(codes.ResourceExhausted, "扣减库存失败")
-	}
-
-	tx := global.DB.Begin()
-	order := model.OrderInfo{
-		OrderSn:      GenerateOrderSn(req.UserId),
-		OrderMount:   orderAmount,
-		Address:      req.Address,
-		SignerName:   req.Name,
-		SingerMobile: req.Mobile,
-		Post:         req.Post,
-		User:         req.UserId,
-	}
-	if result := global.DB.Create(&order); result.Error != nil {
-		tx.Rollback()
+	//扣减库存和本地的订单创建是一个分布式事务，通过saga保证本地事务失败时库存一定会被归还
+	sellInfo := &proto.SellInfo{OrderSn: orderSn, GoodsInfo: goodsInvInfo, Province: provinceOf(order.Address)}
+	warehouses := make(map[int32]int32)
+	order.OrderSn = orderSn
+	order.OrderMount = orderAmount
+	order.User = req.UserId
+	order.Status = model.OrderWaitBuyerPay
+
+	// 扣减库存的原始错误交给saga判断结果是否确定，返回给用户时再统一转换
+	var sellErr error
+	err = saga.New(orderSn).
+		AddStep(StepInventorySell, sellInfo, func(ctx context.Context) error {
+			rsp, err := global.InventorySrvClient.Sell(ctx, sellInfo)
+			if err != nil {
+				sellErr = err
+				return err
+			}
+			// 记录每件商品从哪个仓库发货
+			for _, goodInfo := range rsp.GoodsInfo {
+				warehouses[goodInfo.GoodsId] = goodInfo.WarehouseId
+			}
+			return nil
+		}).
+		Execute(ctx, func(tx *gorm.DB) error {
+			if result := tx.Create(&order); result.Error != nil {
+				return status.Errorf(codes.Internal, "创建订单失败")
+			}
+			if result := tx.Create(&model.OrderStatusHistory{Order: order.ID, ToStatus: order.Status, Remark: "创建订单"}); result.Error != nil {
+				return status.Errorf(codes.Internal, "创建订单失败")
+			}
+
+			for _, orderGood := range orderGoods {
+				orderGood.Order = order.ID
+				orderGood.Warehouse = warehouses[orderGood.InventoryKey]
+			}
+
+			// 批量插入orderGoods
+			if result := tx.CreateInBatches(orderGoods, 100); result.RowsAffected == 0 {
+				return status.Errorf(codes.Internal, "创建订单失败")
+			}
+			// 删除购物车的记录
+			if result := tx.Where(&model.ShoppingCart{User: req.UserId, Checked: true}).Delete(&model.ShoppingCart{}); result.RowsAffected == 0 {
+				return status.Errorf(codes.Internal, "创建订单失败")
+			}
+			// 订单超时未支付自动关闭，和订单在同一个事务中写入
+			if err := delay.Add(tx, TopicOrderTimeout, orderSn, OrderTimeout()); err != nil {
+				return status.Errorf(codes.Internal, "创建订单失败")
+			}
+			return nil
+		})
+	if err != nil {
+		if sellErr != nil {
+			return nil, status.Errorf(codes.ResourceExhausted, "扣减库存失败")
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
 		return nil, status.Errorf(codes.Internal, "创建订单失败")
 	}
+	return orderInfoResponse(&order), nil
+}
 
-	for _, orderGood := range orderGoods {
-		orderGood.Order = order.ID
+// orderAddress 使用收货地址下单时查询地址，把地址的内容保存到订单中，否则使用请求中的地址
+func orderAddress(ctx context.Context, req *proto.OrderRequest) (model.OrderInfo, error) {
+	if req.AddressId == 0 {
+		return model.OrderInfo{
+			Address:      req.Address,
+			SignerName:   req.Name,
+			SingerMobile: req.Mobile,
+			Post:         req.Post,
+		}, nil
 	}
-
-	// 批量插入orderGoods
-	if result := tx.CreateInBatches(orderGoods, 100); result.RowsAffected == 0 {
-		tx.Rollback()
-		return nil, status.Errorf(codes.Internal, "创建订单失败")
+	//跨服务调用 - 用户微服务 —— 查询收货地址，只能使用自己的地址
+	address, err := global.AddressSrvClient.GetAddress(ctx, &proto.AddressRequest{Id: req.AddressId, UserId: req.UserId})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return model.OrderInfo{}, status.Errorf(codes.InvalidArgument, "收货地址不存在")
+		}
+		return model.OrderInfo{}, status.Errorf(codes.Internal, "查询收货地址失败")
 	}
-	// 删除购物车的记录
-	if result := tx.Where(&model.ShoppingCart{User: req.UserId, Checked: true}).Delete(&model.ShoppingCart{}); result.RowsAffected == 0 {
-		tx.Rollback()
-		return nil, status.Errorf(codes.Internal, "创建订单失败")
+	// 直辖市的省份和城市相同，完整地址中只写一次
+	parts := []string{address.Province, address.City, address.District, address.Address}
+	if address.City == address.Province {
+		parts = parts[1:]
 	}
+	return model.OrderInfo{
+		AddressId:    address.Id,
+		Province:     address.Province,
+		City:         address.City,
+		District:     address.District,
+		Address:      strings.Join(parts, ""),
+		SignerName:   address.SignerName,
+		SingerMobile: address.SignerMobile,
+		Post:         address.Post,
+	}, nil
+}
 
-	//提交事务
-	tx.Commit()
-	return &proto.OrderInfoResponse{Id: order.ID, OrderSn: order.OrderSn, Total: order.OrderMount}, nil
+// orderInfoResponse 下单返回的订单信息，重复下单时返回同样的内容
+func orderInfoResponse(order *model.OrderInfo) *proto.OrderInfoResponse {
+	return &proto.OrderInfoResponse{Id: order.ID, OrderSn: order.OrderSn, Total: order.OrderMount}
+}
+
+// skuSpecs 规格的快照，比如"颜色:红色 尺码:L"
+func skuSpecs(sku *proto.SkuInfo) string {
+	parts := make([]string, 0, len(sku.Specs))
+	for _, spec := range sku.Specs {
+		parts = append(parts, spec.Name+":"+spec.Value)
+	}
+	return strings.Join(parts, " ")
 }
 
 func GenerateOrderSn(userId int32) string {
@@ -247,10 +460,49 @@ func GenerateOrderSn(userId int32) string {
 	return orderSn
 }
 
+// UpdateOrderStatus 修改订单状态，只允许状态机中定义的变更
 func (*OrderServer) UpdateOrderStatus(ctx context.Context, req *proto.OrderStatus) (*emptypb.Empty, error) {
-	//先查询，再更新 实际上有两条sql执行， select 和 update语句
-	if result := global.DB.Model(&model.OrderInfo{}).Where("order_sn = ?", req.OrderSn).Update("status", req.Status); result.RowsAffected == 0 {
+	// 旧版本的调用方只填写字符串的status
+	state := req.State
+	if state == proto.OrderState_ORDER_STATE_UNSPECIFIED {
+		state = orderState(req.Status)
+	}
+	to, ok := proto.OrderState_name[int32(state)]
+	if !ok || state == proto.OrderState_ORDER_STATE_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "订单状态不正确")
+	}
+
+	var order model.OrderInfo
+	if result := global.DB.Where(&model.OrderInfo{OrderSn: req.OrderSn}).First(&order); result.RowsAffected == 0 {
 		return nil, status.Errorf(codes.NotFound, "订单不存在")
 	}
+	if order.Status == to {
+		// 支付平台会重复通知，状态相同直接返回成功
+		return &emptypb.Empty{}, nil
+	}
+
+	updates := map[string]interface{}{}
+	// 支付回调会带上支付信息
+	if req.PayType != "" {
+		updates["pay_type"] = req.PayType
+	}
+	if req.TradeNo != "" {
+		updates["trade_no"] = req.TradeNo
+	}
+	if req.PayTime > 0 {
+		updates["pay_time"] = time.Unix(req.PayTime, 0)
+	}
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		return transitOrder(tx, &order, to, updates, "修改订单状态")
+	})
+	if err != nil {
+		if errors.Is(err, errStatusChanged) {
+			return nil, status.Errorf(codes.Aborted, "订单状态已经被修改，请重试")
+		}
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "修改订单状态失败")
+	}
 	return &emptypb.Empty{}, nil
-}
\ No newline at end of file
+}
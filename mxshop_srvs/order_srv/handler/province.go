@@ -0,0 +1,21 @@
+package handler
+
+import "strings"
+
+// provinces 省级行政区的简称，收货地址以省份开头
+var provinces = []string{
+	"北京", "天津", "上海", "重庆", "河北", "山西", "辽宁", "吉林", "黑龙江", "江苏", "浙江", "安徽",
+	"福建", "江西", "山东", "河南", "湖北", "湖南", "广东", "海南", "四川", "贵州", "云南", "陕西",
+	"甘肃", "青海", "台湾", "内蒙古", "广西", "西藏", "宁夏", "新疆", "香港", "澳门",
+}
+
+// provinceOf 从收货地址中解析省份，库存服务按照省份选择就近的仓库，解析不到返回空字符串
+func provinceOf(address string) string {
+	address = strings.TrimSpace(address)
+	for _, province := range provinces {
+		if strings.HasPrefix(address, province) {
+			return province
+		}
+	}
+	return ""
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/proto"
+)
+
+// 下单saga中的步骤名称，会持久化到数据库，不要随意修改
+const (
+	StepInventorySell = "inventory_sell"
+)
+
+// RebackCompensator 扣减库存的补偿操作 —— 按订单号归还库存
+func RebackCompensator(ctx context.Context, orderSn string, payload []byte) error {
+	var sellInfo proto.SellInfo
+	if err := json.Unmarshal(payload, &sellInfo); err != nil {
+		return err
+	}
+	sellInfo.OrderSn = orderSn
+	_, err := global.InventorySrvClient.Reback(ctx, &sellInfo)
+	return err
+}
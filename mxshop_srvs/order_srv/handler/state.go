@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/order_srv/model"
+	"mxshop_srvs/order_srv/proto"
+)
+
+// errStatusChanged 修改的时候订单状态已经被其他请求修改了
+var errStatusChanged = errors.New("订单状态已经被修改")
+
+// transitOrder 修改订单状态并记录变更，需要在事务中调用
+// 只有订单的状态还是查询时的状态才会修改成功，避免并发的请求覆盖彼此的修改
+func transitOrder(tx *gorm.DB, order *model.OrderInfo, to string, updates map[string]interface{}, remark string) error {
+	if !model.CanTransit(order.Status, to) {
+		return status.Errorf(codes.FailedPrecondition, "订单状态不能从%s修改为%s", order.Status, to)
+	}
+	if updates == nil {
+		updates = map[string]interface{}{}
+	}
+	updates["status"] = to
+	result := tx.Model(&model.OrderInfo{}).Where("id = ? and status = ?", order.ID, order.Status).Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errStatusChanged
+	}
+	return tx.Create(&model.OrderStatusHistory{
+		Order:      order.ID,
+		FromStatus: order.Status,
+		ToStatus:   to,
+		Remark:     remark,
+	}).Error
+}
+
+// orderState 数据库中保存的状态转换为proto中的枚举
+func orderState(s string) proto.OrderState {
+	return proto.OrderState(proto.OrderState_value[s])
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/model"
+	"mxshop_srvs/order_srv/proto"
+)
+
+// TopicOrderTimeout 订单超时关闭的延时任务
+const TopicOrderTimeout = "order_timeout"
+
+// OrderTimeout 订单超时未支付自动关闭的时间
+func OrderTimeout() time.Duration {
+	if global.ServerConfig.OrderTimeout > 0 {
+		return time.Duration(global.ServerConfig.OrderTimeout) * time.Second
+	}
+	return 30 * time.Minute
+}
+
+// CloseTimeoutOrder 关闭超时未支付的订单并归还库存
+// 延时任务可能被重复执行，所以这里要保证幂等：
+//  1. 只有待支付的订单才会被关闭，通过状态机的条件更新避免和支付回调并发修改
+//  2. 订单已经是关闭状态说明上一次执行关闭成功，归还库存成功之后会在订单上记录，已经记录的不再归还
+//  3. 归还成功但是记录之前崩溃时会再次归还，这种情况依赖库存服务按订单号记录的归还流水去重
+func CloseTimeoutOrder(ctx context.Context, orderSn string) error {
+	var order model.OrderInfo
+	if result := global.DB.Where(&model.OrderInfo{OrderSn: orderSn}).First(&order); result.RowsAffected == 0 {
+		return nil
+	}
+
+	switch order.Status {
+	case model.OrderWaitBuyerPay, model.OrderPaying:
+		// 关闭之前订单状态被修改了(比如用户刚好完成了支付)会返回错误，任务重试时按照最新的状态处理
+		if err := global.DB.Transaction(func(tx *gorm.DB) error {
+			return transitOrder(tx, &order, model.OrderTradeClosed, nil, "超时未支付")
+		}); err != nil {
+			return err
+		}
+	case model.OrderTradeClosed:
+		if order.StockReturned {
+			return nil
+		}
+	default:
+		// 已经支付的订单不需要关闭
+		return nil
+	}
+
+	var orderGoods []model.OrderGoods
+	if result := global.DB.Where(&model.OrderGoods{Order: order.ID}).Find(&orderGoods); result.Error != nil {
+		return result.Error
+	}
+	var goodsInfo []*proto.GoodsInvInfo
+	for _, orderGood := range orderGoods {
+		// 以前的订单没有保存库存的商品id，就是商品id
+		key := orderGood.InventoryKey
+		if key == 0 {
+			key = orderGood.Goods
+		}
+		goodsInfo = append(goodsInfo, &proto.GoodsInvInfo{
+			GoodsId: key,
+			Num:     orderGood.Nums,
+		})
+	}
+	if _, err := global.InventorySrvClient.Reback(ctx, &proto.SellInfo{OrderSn: orderSn, GoodsInfo: goodsInfo}); err != nil {
+		return err
+	}
+	return global.DB.Model(&model.OrderInfo{}).Where("id = ?", order.ID).Update("stock_returned", true).Error
+}
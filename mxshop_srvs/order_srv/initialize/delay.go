@@ -0,0 +1,16 @@
+package initialize
+
+import (
+	"time"
+
+	"mxshop_srvs/order_srv/delay"
+	"mxshop_srvs/order_srv/handler"
+)
+
+func InitDelayJob() {
+	// 订单超时关闭
+	delay.Register(handler.TopicOrderTimeout, handler.CloseTimeoutOrder)
+
+	// 每5秒轮询一次到期的任务，失败最多重试20次
+	go delay.Run(5*time.Second, 20)
+}
@@ -0,0 +1,16 @@
+package initialize
+
+import (
+	"time"
+
+	"mxshop_srvs/order_srv/handler"
+	"mxshop_srvs/order_srv/saga"
+)
+
+func InitSaga() {
+	// 注册下单saga的补偿操作
+	saga.Register(handler.StepInventorySell, handler.RebackCompensator)
+
+	// 后台重试补偿失败的saga，5分钟还没有完成的saga认为服务已经崩溃，最多重试10次
+	go saga.RunRecovery(30*time.Second, 5*time.Minute, 10)
+}
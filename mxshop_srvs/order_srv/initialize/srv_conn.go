@@ -37,4 +37,16 @@ func InitSrvConn() {
 	}
 
 	global.InventorySrvClient = proto.NewInventoryClient(invConn)
+
+	//初始化用户服务连接，下单时查询收货地址
+	userConn, err := grpc.Dial(
+		fmt.Sprintf("consul://%s:%d/%s?wait=14s", consulInfo.Host, consulInfo.Port, global.ServerConfig.UserSrvInfo.Name),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(`{"loadBalancingPolicy": "round_robin"}`),
+	)
+	if err != nil {
+		zap.S().Fatal("[InitSrvConn] 连接 【用户服务失败】")
+	}
+
+	global.AddressSrvClient = proto.NewAddressClient(userConn)
 }
\ No newline at end of file
@@ -28,6 +28,8 @@ func main() {
 	initialize.InitConfig()
 	initialize.InitDB()
 	initialize.InitSrvConn()
+	initialize.InitSaga()
+	initialize.InitDelayJob()
 
 	IP := flag.String("ip", "0.0.0.0", "ip地址")
 	Port := flag.Int("port", 50060, "端口号") // 这个修改为0，如果我们从命令行带参数启动的话就不会为0
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// 延时任务的状态
+const (
+	JobPending = "PENDING" // 等待执行
+	JobRunning = "RUNNING" // 执行中，被某个副本占用
+	JobDone    = "DONE"    // 执行成功
+	JobFailed  = "FAILED"  // 重试次数用完仍然失败
+)
+
+// DelayJob 延时任务，比如订单超时关闭
+// Topic + BizKey 唯一，同一个订单的同一类任务只会有一条
+type DelayJob struct {
+	BaseModel
+	Topic      string     `gorm:"type:varchar(50);index:idx_topic_key,unique"`
+	BizKey     string     `gorm:"type:varchar(50);index:idx_topic_key,unique"` // 业务key，比如订单号
+	RunAt      time.Time  `gorm:"type:datetime;index"`                         // 任务到期时间
+	Status     string     `gorm:"type:varchar(20);index comment 'PENDING, RUNNING, DONE, FAILED'"`
+	Attempts   int32      `gorm:"type:int;default:0"`
+	Owner      string     `gorm:"type:varchar(100)"` // 占用任务的副本
+	LeaseUntil *time.Time `gorm:"type:datetime"`     // 占用的过期时间，副本崩溃后任务会被其他副本重新执行
+	Error      string     `gorm:"type:varchar(500)"`
+}
+
+func (DelayJob) TableName() string {
+	return "delayjob"
+}
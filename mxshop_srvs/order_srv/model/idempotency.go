@@ -0,0 +1,14 @@
+package model
+
+// OrderIdempotency 下单请求的幂等key和创建的订单号
+// 同一个用户的同一个key只能创建一个订单，订单创建失败时会删除记录，客户端可以用同一个key重试
+type OrderIdempotency struct {
+	BaseModel
+	User    int32  `gorm:"type:int;index:idx_user_key,unique"`
+	IdemKey string `gorm:"type:varchar(64);index:idx_user_key,unique"`
+	OrderSn string `gorm:"type:varchar(30)"`
+}
+
+func (OrderIdempotency) TableName() string {
+	return "orderidempotency"
+}
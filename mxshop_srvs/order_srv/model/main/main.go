@@ -34,5 +34,6 @@ func main() {
 		panic(err)
 	}
 
-	_ = db.AutoMigrate(&model.ShoppingCart{}, &model.OrderInfo{}, &model.OrderGoods{})
+	_ = db.AutoMigrate(&model.ShoppingCart{}, &model.OrderInfo{}, &model.OrderGoods{}, &model.OrderStatusHistory{}, &model.OrderIdempotency{},
+		&model.OrderSaga{}, &model.OrderSagaStep{}, &model.DelayJob{})
 }
\ No newline at end of file
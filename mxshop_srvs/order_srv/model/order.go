@@ -8,12 +8,40 @@ type ShoppingCart struct { // 购物车
 	Goods   int32 `gorm:"type:int;index"` // 加索引：我们需要查询时候， 1. 会影响插入性能 2. 会占用磁盘
 	Nums    int32 `gorm:"type:int"`       // 商品的数量
 	Checked bool  // 是否选中
+	Sku     int32 `gorm:"type:int;default:0"` // 商品的规格，0表示商品没有规格
 }
 
 func (ShoppingCart) TableName() string {
 	return "shoppingcart"
 }
 
+// 订单的状态
+const (
+	OrderWaitBuyerPay  = "WAIT_BUYER_PAY" // 交易创建
+	OrderPaying        = "PAYING"         // 待支付
+	OrderTradeSuccess  = "TRADE_SUCCESS"  // 成功
+	OrderTradeClosed   = "TRADE_CLOSED"   // 超时关闭
+	OrderTradeFinished = "TRADE_FINISHED" // 交易结束
+)
+
+// orderTransitions 订单状态允许的变更，关闭和结束的订单不能再修改
+// 支付宝不可退款的交易会直接通知TRADE_FINISHED，所以未支付的订单也可以直接结束
+var orderTransitions = map[string][]string{
+	OrderWaitBuyerPay: {OrderPaying, OrderTradeSuccess, OrderTradeFinished, OrderTradeClosed},
+	OrderPaying:       {OrderTradeSuccess, OrderTradeFinished, OrderTradeClosed},
+	OrderTradeSuccess: {OrderTradeFinished},
+}
+
+// CanTransit 订单状态能否从from修改为to
+func CanTransit(from, to string) bool {
+	for _, status := range orderTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
 type OrderInfo struct {
 	BaseModel
 
@@ -26,8 +54,16 @@ type OrderInfo struct {
 	TradeNo    string `gorm:"type:varchar(100) comment '交易号'"` // 交易号就是支付宝的订单号，查账
 	OrderMount float32
 	PayTime    *time.Time `gorm:"type:datetime"`
+	// 关闭订单之后库存是否已经归还，超时任务重复执行时不再归还
+	StockReturned bool `gorm:"not null;default:false"`
 
-	Address      string `gorm:"type:varchar(100)"`
+	// 使用收货地址下单时保存地址的内容，之后修改或者删除收货地址不影响订单
+	// Address是包括省市区的完整地址
+	AddressId    int32  `gorm:"type:int;not null;default:0"`
+	Province     string `gorm:"type:varchar(20);not null;default:''"`
+	City         string `gorm:"type:varchar(20);not null;default:''"`
+	District     string `gorm:"type:varchar(20);not null;default:''"`
+	Address      string `gorm:"type:varchar(200)"`
 	SignerName   string `gorm:"type:varchar(20)"`
 	SingerMobile string `gorm:"type:varchar(11)"`
 	Post         string `gorm:"type:varchar(20)"`
@@ -48,8 +84,26 @@ type OrderGoods struct {
 	GoodsImage string `gorm:"type:varchar(200)"`
 	GoodsPrice float32
 	Nums       int32 `gorm:"type:int"`
+	Warehouse  int32 `gorm:"type:int comment '发货的仓库'"`
+
+	Sku          int32  `gorm:"type:int;default:0"`
+	SkuSpecs     string `gorm:"type:varchar(200) comment '下单时的规格'"`
+	InventoryKey int32  `gorm:"type:int comment '库存服务中的商品id，有规格时是SKU的id'"`
 }
 
 func (OrderGoods) TableName() string {
 	return "ordergoods"
-}
\ No newline at end of file
+}
+
+// OrderStatusHistory 订单状态的变更记录，每次修改订单状态都会记录一条
+type OrderStatusHistory struct {
+	BaseModel
+	Order      int32  `gorm:"type:int;index"`
+	FromStatus string `gorm:"type:varchar(20)"` // 创建订单时为空
+	ToStatus   string `gorm:"type:varchar(20)"`
+	Remark     string `gorm:"type:varchar(100)"`
+}
+
+func (OrderStatusHistory) TableName() string {
+	return "orderstatushistory"
+}
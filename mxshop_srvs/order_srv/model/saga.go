@@ -0,0 +1,47 @@
+package model
+
+// saga的状态
+const (
+	SagaStarted          = "STARTED"           // 事务执行中
+	SagaCompleted        = "COMPLETED"         // 所有步骤执行成功
+	SagaCompensating     = "COMPENSATING"      // 有步骤失败，正在补偿
+	SagaCompensated      = "COMPENSATED"       // 补偿完成
+	SagaCompensateFailed = "COMPENSATE_FAILED" // 补偿失败，等待后台重试
+)
+
+// saga步骤的状态
+const (
+	StepPending          = "PENDING"
+	StepDone             = "DONE"
+	StepFailed           = "FAILED"
+	StepCompensated      = "COMPENSATED"
+	StepCompensateFailed = "COMPENSATE_FAILED"
+)
+
+// OrderSaga 下单的分布式事务记录，一个订单号对应一条记录
+type OrderSaga struct {
+	BaseModel
+	OrderSn string `gorm:"type:varchar(30);uniqueIndex"`
+	Status  string `gorm:"type:varchar(20);index comment 'STARTED, COMPLETED, COMPENSATING, COMPENSATED, COMPENSATE_FAILED'"`
+	Retries int32  `gorm:"type:int;default:0"` // 后台补偿重试的次数
+}
+
+func (OrderSaga) TableName() string {
+	return "ordersaga"
+}
+
+// OrderSagaStep saga中每个步骤的执行记录
+// Payload 保存了补偿需要的参数，服务重启后仍然可以根据它完成补偿
+type OrderSagaStep struct {
+	BaseModel
+	OrderSn string `gorm:"type:varchar(30);index:idx_order_step,unique"`
+	Step    string `gorm:"type:varchar(50);index:idx_order_step,unique"`
+	Seq     int32  `gorm:"type:int"` // 步骤的执行顺序，补偿时倒序执行
+	Status  string `gorm:"type:varchar(20) comment 'PENDING, DONE, FAILED, COMPENSATED, COMPENSATE_FAILED'"`
+	Payload string `gorm:"type:text"`
+	Error   string `gorm:"type:varchar(500)"`
+}
+
+func (OrderSagaStep) TableName() string {
+	return "ordersagastep"
+}
@@ -28,6 +28,7 @@ const (
 	Order_OrderList_FullMethodName         = "/Order/OrderList"
 	Order_OrderDetail_FullMethodName       = "/Order/OrderDetail"
 	Order_UpdateOrderStatus_FullMethodName = "/Order/UpdateOrderStatus"
+	Order_OrderGoodsDetail_FullMethodName  = "/Order/OrderGoodsDetail"
 )
 
 // OrderClient is the client API for Order service.
@@ -44,6 +45,7 @@ type OrderClient interface {
 	OrderList(ctx context.Context, in *OrderFilterRequest, opts ...grpc.CallOption) (*OrderListResponse, error)
 	OrderDetail(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderInfoDetailResponse, error)
 	UpdateOrderStatus(ctx context.Context, in *OrderStatus, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	OrderGoodsDetail(ctx context.Context, in *OrderGoodsRequest, opts ...grpc.CallOption) (*OrderGoodsResponse, error)
 }
 
 type orderClient struct {
@@ -134,6 +136,16 @@ func (c *orderClient) UpdateOrderStatus(ctx context.Context, in *OrderStatus, op
 	return out, nil
 }
 
+func (c *orderClient) OrderGoodsDetail(ctx context.Context, in *OrderGoodsRequest, opts ...grpc.CallOption) (*OrderGoodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OrderGoodsResponse)
+	err := c.cc.Invoke(ctx, Order_OrderGoodsDetail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServer is the server API for Order service.
 // All implementations must embed UnimplementedOrderServer
 // for forward compatibility.
@@ -148,6 +160,7 @@ type OrderServer interface {
 	OrderList(context.Context, *OrderFilterRequest) (*OrderListResponse, error)
 	OrderDetail(context.Context, *OrderRequest) (*OrderInfoDetailResponse, error)
 	UpdateOrderStatus(context.Context, *OrderStatus) (*emptypb.Empty, error)
+	OrderGoodsDetail(context.Context, *OrderGoodsRequest) (*OrderGoodsResponse, error)
 	mustEmbedUnimplementedOrderServer()
 }
 
@@ -182,6 +195,9 @@ func (UnimplementedOrderServer) OrderDetail(context.Context, *OrderRequest) (*Or
 func (UnimplementedOrderServer) UpdateOrderStatus(context.Context, *OrderStatus) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
 }
+func (UnimplementedOrderServer) OrderGoodsDetail(context.Context, *OrderGoodsRequest) (*OrderGoodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderGoodsDetail not implemented")
+}
 func (UnimplementedOrderServer) mustEmbedUnimplementedOrderServer() {}
 func (UnimplementedOrderServer) testEmbeddedByValue()               {}
 
@@ -347,6 +363,24 @@ func _Order_UpdateOrderStatus_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_OrderGoodsDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).OrderGoodsDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_OrderGoodsDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).OrderGoodsDetail(ctx, req.(*OrderGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Order_ServiceDesc is the grpc.ServiceDesc for Order service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -386,6 +420,10 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _Order_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "OrderGoodsDetail",
+			Handler:    _Order_OrderGoodsDetail_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "order.proto",
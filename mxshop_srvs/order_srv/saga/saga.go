@@ -0,0 +1,218 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/model"
+)
+
+/*
+	下单是一个跨服务的分布式事务：
+		1. 库存服务扣减库存 (远程调用)
+		2. 本地事务：创建订单、订单商品，删除购物车记录
+	这里使用saga模式：每个远程步骤都要有对应的补偿操作，步骤和补偿参数都会持久化到数据库，
+	任何一步失败都会倒序执行已完成步骤的补偿；补偿失败的记录由后台任务继续重试，服务重启后也不会丢失
+*/
+
+// Compensator 补偿操作，payload是执行步骤时持久化下来的参数
+type Compensator func(ctx context.Context, orderSn string, payload []byte) error
+
+var (
+	compensatorsMu sync.RWMutex
+	compensators   = map[string]Compensator{}
+)
+
+// Register 注册某个步骤的补偿操作，后台重试时通过步骤名称找到对应的补偿
+// 只补偿执行成功的步骤和结果不确定的步骤(执行中崩溃、请求超时)，明确失败的步骤不补偿
+// 结果不确定的步骤可能实际上没有执行，所以补偿操作必须能处理步骤没有执行过的情况
+func Register(step string, c Compensator) {
+	compensatorsMu.Lock()
+	defer compensatorsMu.Unlock()
+	compensators[step] = c
+}
+
+func getCompensator(step string) (Compensator, bool) {
+	compensatorsMu.RLock()
+	defer compensatorsMu.RUnlock()
+	c, ok := compensators[step]
+	return c, ok
+}
+
+type step struct {
+	name    string
+	payload interface{}
+	action  func(ctx context.Context) error
+}
+
+// Saga 一次下单对应的分布式事务，以订单号作为全局的事务id
+type Saga struct {
+	orderSn string
+	steps   []step
+}
+
+func New(orderSn string) *Saga {
+	return &Saga{orderSn: orderSn}
+}
+
+// AddStep 添加一个远程步骤，payload会被序列化保存，用于之后的补偿
+func (s *Saga) AddStep(name string, payload interface{}, action func(ctx context.Context) error) *Saga {
+	s.steps = append(s.steps, step{name: name, payload: payload, action: action})
+	return s
+}
+
+// Execute 依次执行所有的远程步骤，最后执行本地事务
+// 本地事务和saga的完成状态在同一个数据库事务中提交，这样服务在任何时刻崩溃都能通过saga状态判断是否需要补偿
+// 返回的error就是失败步骤返回的error，方便调用方直接返回grpc的status
+func (s *Saga) Execute(ctx context.Context, local func(tx *gorm.DB) error) error {
+	if result := global.DB.Create(&model.OrderSaga{OrderSn: s.orderSn, Status: model.SagaStarted}); result.Error != nil {
+		return result.Error
+	}
+
+	for i, st := range s.steps {
+		payload, err := json.Marshal(st.payload)
+		if err != nil {
+			return err
+		}
+		record := model.OrderSagaStep{
+			OrderSn: s.orderSn,
+			Step:    st.name,
+			Seq:     int32(i),
+			Status:  model.StepPending,
+			Payload: string(payload),
+		}
+		if result := global.DB.Create(&record); result.Error != nil {
+			s.compensate()
+			return result.Error
+		}
+
+		if err = st.action(ctx); err != nil {
+			zap.S().Errorf("[saga] 订单 %s 步骤 %s 执行失败: %s", s.orderSn, st.name, err.Error())
+			// 超时等结果不确定的错误保持PENDING，和执行中崩溃一样需要补偿
+			stepStatus := model.StepFailed
+			if uncertain(err) {
+				stepStatus = model.StepPending
+			}
+			global.DB.Model(&record).Updates(map[string]interface{}{"status": stepStatus, "error": truncate(err.Error())})
+			s.compensate()
+			return err
+		}
+		global.DB.Model(&record).Update("status", model.StepDone)
+	}
+
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if err := local(tx); err != nil {
+			return err
+		}
+		return tx.Model(&model.OrderSaga{}).Where("order_sn = ?", s.orderSn).Update("status", model.SagaCompleted).Error
+	})
+	if err != nil {
+		zap.S().Errorf("[saga] 订单 %s 本地事务执行失败: %s", s.orderSn, err.Error())
+		s.compensate()
+		return err
+	}
+	return nil
+}
+
+// compensate 补偿不能使用请求的ctx，请求被取消之后补偿仍然需要完成
+func (s *Saga) compensate() {
+	Compensate(context.Background(), s.orderSn)
+}
+
+// uncertain 远程步骤的结果是否不确定：请求超时或者连接断开时对方可能已经执行成功了
+func uncertain(err error) bool {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Canceled, codes.Unavailable, codes.Unknown:
+		return true
+	}
+	return false
+}
+
+// Compensate 倒序补偿某个订单执行成功或者结果不确定的步骤，明确失败的步骤没有产生影响，不补偿
+func Compensate(ctx context.Context, orderSn string) error {
+	global.DB.Model(&model.OrderSaga{}).Where("order_sn = ?", orderSn).Update("status", model.SagaCompensating)
+
+	var steps []model.OrderSagaStep
+	if result := global.DB.Where("order_sn = ? and status in ?", orderSn,
+		[]string{model.StepDone, model.StepPending, model.StepCompensateFailed}).
+		Order("seq desc").Find(&steps); result.Error != nil {
+		return result.Error
+	}
+
+	var lastErr error
+	for _, st := range steps {
+		c, ok := getCompensator(st.Step)
+		if !ok {
+			// 没有注册补偿的步骤不需要补偿
+			global.DB.Model(&st).Update("status", model.StepCompensated)
+			continue
+		}
+		if err := c(ctx, orderSn, []byte(st.Payload)); err != nil {
+			zap.S().Errorf("[saga] 订单 %s 步骤 %s 补偿失败: %s", orderSn, st.Step, err.Error())
+			global.DB.Model(&st).Updates(map[string]interface{}{"status": model.StepCompensateFailed, "error": truncate(err.Error())})
+			lastErr = err
+			continue
+		}
+		global.DB.Model(&st).Update("status", model.StepCompensated)
+	}
+
+	sagaStatus := model.SagaCompensated
+	if lastErr != nil {
+		sagaStatus = model.SagaCompensateFailed
+	}
+	global.DB.Model(&model.OrderSaga{}).Where("order_sn = ?", orderSn).Update("status", sagaStatus)
+	return lastErr
+}
+
+// Recover 重试补偿失败的saga，同时处理服务崩溃后停留在执行中的saga
+// staleAfter 之前还没有完成的saga认为执行它的服务已经崩溃了
+// 多个副本同时执行时通过乐观锁抢占，同一个saga只会被一个副本处理
+func Recover(ctx context.Context, staleAfter time.Duration, maxRetries int32) {
+	var sagas []model.OrderSaga
+	global.DB.Where("status = ? or (status in ? and update_time < ?)",
+		model.SagaCompensateFailed,
+		[]string{model.SagaStarted, model.SagaCompensating},
+		time.Now().Add(-staleAfter),
+	).Where("retries < ?", maxRetries).Limit(100).Find(&sagas)
+
+	for _, s := range sagas {
+		if result := global.DB.Model(&model.OrderSaga{}).Where("id = ? and retries = ?", s.ID, s.Retries).
+			Update("retries", s.Retries+1); result.RowsAffected == 0 {
+			continue // 已经被其他副本处理了
+		}
+		if err := Compensate(ctx, s.OrderSn); err != nil {
+			if s.Retries+1 >= maxRetries {
+				zap.S().Errorf("[saga] 订单 %s 补偿重试 %d 次仍然失败，需要人工处理", s.OrderSn, maxRetries)
+			}
+			continue
+		}
+		zap.S().Infof("[saga] 订单 %s 补偿完成", s.OrderSn)
+	}
+}
+
+// RunRecovery 后台定时执行Recover
+func RunRecovery(interval, staleAfter time.Duration, maxRetries int32) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		Recover(context.Background(), staleAfter, maxRetries)
+	}
+}
+
+// truncate 错误信息截断，避免超出字段长度
+func truncate(s string) string {
+	r := []rune(s)
+	if len(r) > 200 {
+		return fmt.Sprintf("%s...", string(r[:200]))
+	}
+	return s
+}
@@ -0,0 +1,73 @@
+package fake
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"mxshop_srvs/order_srv/proto"
+)
+
+// GoodsClient 进程内的商品服务，只实现了下单需要用到的接口
+// 没有实现的接口调用时会panic
+type GoodsClient struct {
+	proto.GoodsClient
+
+	mu    sync.RWMutex
+	goods map[int32]*proto.GoodsInfoResponse
+	skus  map[int32]*proto.SkuInfo
+}
+
+func NewGoodsClient(goods ...*proto.GoodsInfoResponse) *GoodsClient {
+	c := &GoodsClient{
+		goods: make(map[int32]*proto.GoodsInfoResponse),
+		skus:  make(map[int32]*proto.SkuInfo),
+	}
+	for _, g := range goods {
+		c.goods[g.Id] = g
+	}
+	return c
+}
+
+func (c *GoodsClient) BatchGetGoods(ctx context.Context, in *proto.BatchGoodsIdInfo, opts ...grpc.CallOption) (*proto.GoodsListResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	rsp := &proto.GoodsListResponse{}
+	for _, id := range in.Id {
+		if g, ok := c.goods[id]; ok {
+			rsp.Data = append(rsp.Data, g)
+		}
+	}
+	rsp.Total = int32(len(rsp.Data))
+	return rsp, nil
+}
+
+func (c *GoodsClient) GetGoodsDetail(ctx context.Context, in *proto.GoodInfoRequest, opts ...grpc.CallOption) (*proto.GoodsInfoResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if g, ok := c.goods[in.Id]; ok {
+		return g, nil
+	}
+	return nil, errNotFound
+}
+
+// AddSku 添加商品的SKU
+func (c *GoodsClient) AddSku(sku *proto.SkuInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.skus[sku.Id] = sku
+}
+
+func (c *GoodsClient) BatchGetSku(ctx context.Context, in *proto.BatchSkuIdInfo, opts ...grpc.CallOption) (*proto.SkuListResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	rsp := &proto.SkuListResponse{}
+	for _, id := range in.Id {
+		if sku, ok := c.skus[id]; ok {
+			rsp.Data = append(rsp.Data, sku)
+		}
+	}
+	rsp.Total = int32(len(rsp.Data))
+	return rsp, nil
+}
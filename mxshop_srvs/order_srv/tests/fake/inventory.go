@@ -0,0 +1,127 @@
+package fake
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"mxshop_srvs/order_srv/proto"
+)
+
+var errNotFound = status.Errorf(codes.NotFound, "记录不存在")
+
+// Warehouse 扣减库存返回的发货仓库
+const Warehouse = 1
+
+// InventoryClient 进程内的库存服务，只有一个仓库
+// 和真实的库存服务一样以订单号记录扣减，Reback只归还这个订单扣减过的库存
+type InventoryClient struct {
+	proto.InventoryClient
+
+	mu       sync.Mutex
+	stocks   map[int32]int32
+	sold     map[string][]*proto.GoodsInvInfo
+	returned map[string]bool
+
+	// 下面的字段用于模拟异常，SellErrAfterDeduct模拟扣减成功之后响应超时
+	SellErr            error
+	SellErrAfterDeduct error
+	RebackErr          error
+
+	SellCalls   int
+	RebackCalls int
+}
+
+func NewInventoryClient(stocks map[int32]int32) *InventoryClient {
+	c := &InventoryClient{
+		stocks:   make(map[int32]int32),
+		sold:     make(map[string][]*proto.GoodsInvInfo),
+		returned: make(map[string]bool),
+	}
+	for goodsId, num := range stocks {
+		c.stocks[goodsId] = num
+	}
+	return c
+}
+
+// Stocks 查询当前的库存
+func (c *InventoryClient) Stocks(goodsId int32) int32 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stocks[goodsId]
+}
+
+func (c *InventoryClient) InvDetail(ctx context.Context, in *proto.GoodsInvInfo, opts ...grpc.CallOption) (*proto.GoodsInvInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	num, ok := c.stocks[in.GoodsId]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "库存信息不存在")
+	}
+	return &proto.GoodsInvInfo{GoodsId: in.GoodsId, Num: num}, nil
+}
+
+func (c *InventoryClient) Sell(ctx context.Context, in *proto.SellInfo, opts ...grpc.CallOption) (*proto.SellInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.SellCalls++
+	if c.SellErr != nil {
+		return nil, c.SellErr
+	}
+	if c.returned[in.OrderSn] {
+		return nil, status.Errorf(codes.FailedPrecondition, "订单的库存已经归还")
+	}
+	if goodsInfo, ok := c.sold[in.OrderSn]; ok {
+		return sellResult(in.OrderSn, goodsInfo), nil
+	}
+	// 先检查所有的商品，保证和数据库事务一样要么全部扣减要么全部不扣减
+	for _, goodInfo := range in.GoodsInfo {
+		num, ok := c.stocks[goodInfo.GoodsId]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "没有库存信息")
+		}
+		if num < goodInfo.Num {
+			return nil, status.Errorf(codes.ResourceExhausted, "库存不足")
+		}
+	}
+	for _, goodInfo := range in.GoodsInfo {
+		c.stocks[goodInfo.GoodsId] -= goodInfo.Num
+	}
+	c.sold[in.OrderSn] = in.GoodsInfo
+	if c.SellErrAfterDeduct != nil {
+		return nil, c.SellErrAfterDeduct
+	}
+	return sellResult(in.OrderSn, in.GoodsInfo), nil
+}
+
+func sellResult(orderSn string, goodsInfo []*proto.GoodsInvInfo) *proto.SellInfo {
+	rsp := &proto.SellInfo{OrderSn: orderSn}
+	for _, goodInfo := range goodsInfo {
+		rsp.GoodsInfo = append(rsp.GoodsInfo, &proto.GoodsInvInfo{GoodsId: goodInfo.GoodsId, Num: goodInfo.Num, WarehouseId: Warehouse})
+	}
+	return rsp
+}
+
+func (c *InventoryClient) Reback(ctx context.Context, in *proto.SellInfo, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.RebackCalls++
+	if c.RebackErr != nil {
+		return nil, c.RebackErr
+	}
+	// 没有扣减过的订单也记录为已归还，之后到达的扣减不会再扣减库存
+	c.returned[in.OrderSn] = true
+	goodsInfo, ok := c.sold[in.OrderSn]
+	if !ok {
+		return &emptypb.Empty{}, nil
+	}
+	for _, goodInfo := range goodsInfo {
+		c.stocks[goodInfo.GoodsId] += goodInfo.Num
+	}
+	delete(c.sold, in.OrderSn)
+	return &emptypb.Empty{}, nil
+}
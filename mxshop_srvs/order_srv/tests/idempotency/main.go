@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/handler"
+	"mxshop_srvs/order_srv/initialize"
+	"mxshop_srvs/order_srv/model"
+	"mxshop_srvs/order_srv/proto"
+	"mxshop_srvs/order_srv/tests/fake"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+/*
+	下单幂等的测试，商品服务和库存服务使用进程内的fake，只依赖本地的mysql
+*/
+
+const testUser = 9996
+
+var (
+	inv         *fake.InventoryClient
+	orderServer = &handler.OrderServer{}
+)
+
+func Init() {
+	initialize.InitLogger()
+	inv = fake.NewInventoryClient(map[int32]int32{1: 100})
+	global.InventorySrvClient = inv
+	global.GoodsSrvClient = fake.NewGoodsClient(&proto.GoodsInfoResponse{Id: 1, Name: "测试商品1", ShopPrice: 10})
+}
+
+func createOrder(key string) (*proto.OrderInfoResponse, error) {
+	return orderServer.CreateOrder(context.Background(), &proto.OrderRequest{
+		UserId:         testUser,
+		Address:        "北京市",
+		Name:           "bobby",
+		Mobile:         "18782222220",
+		Post:           "100000",
+		IdempotencyKey: key,
+	})
+}
+
+// TestRepeat 同一个key重复下单返回同一个订单，库存只扣减一次
+func TestRepeat() {
+	global.DB.Create(&model.ShoppingCart{User: testUser, Goods: 1, Nums: 1, Checked: true})
+	key := uuid.NewV4().String()
+	first, err := createOrder(key)
+	if err != nil {
+		panic(err)
+	}
+	// 购物车已经清空了，重复的请求仍然返回成功
+	second, err := createOrder(key)
+	if err != nil {
+		panic(err)
+	}
+	if first.OrderSn != second.OrderSn || first.Id != second.Id || first.Total != second.Total {
+		panic(fmt.Sprintf("重复下单应该返回同一个订单: %v %v", first, second))
+	}
+	if inv.Stocks(1) != 99 {
+		panic(fmt.Sprintf("库存只应该扣减一次，实际库存%d", inv.Stocks(1)))
+	}
+	fmt.Println("重复下单返回同一个订单", first.OrderSn)
+}
+
+// TestConcurrent 同时提交的请求只有一个会创建订单
+func TestConcurrent() {
+	global.DB.Create(&model.ShoppingCart{User: testUser, Goods: 1, Nums: 1, Checked: true})
+	key := uuid.NewV4().String()
+	before := inv.SellCalls
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	orderSns := map[string]bool{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rsp, err := createOrder(key)
+			if err != nil {
+				if status.Code(err) != codes.Aborted {
+					panic(err)
+				}
+				return
+			}
+			mu.Lock()
+			orderSns[rsp.OrderSn] = true
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if len(orderSns) != 1 || inv.SellCalls-before != 1 {
+		panic(fmt.Sprintf("并发下单应该只创建一个订单，实际创建%d个，扣减库存%d次", len(orderSns), inv.SellCalls-before))
+	}
+	fmt.Println("并发下单只创建了一个订单")
+}
+
+// TestRetryAfterFailure 下单失败之后可以用同一个key重试
+func TestRetryAfterFailure() {
+	global.DB.Create(&model.ShoppingCart{User: testUser, Goods: 1, Nums: 1, Checked: true})
+	key := uuid.NewV4().String()
+
+	inv.SellErr = status.Errorf(codes.ResourceExhausted, "库存不足")
+	if _, err := createOrder(key); err == nil {
+		panic("扣减库存失败时下单应该失败")
+	}
+	inv.SellErr = nil
+
+	if _, err := createOrder(key); err != nil {
+		panic(err)
+	}
+	fmt.Println("下单失败之后重试成功")
+}
+
+func main() {
+	Init()
+	TestRepeat()
+	TestConcurrent()
+	TestRetryAfterFailure()
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/handler"
+	"mxshop_srvs/order_srv/initialize"
+	"mxshop_srvs/order_srv/model"
+	"mxshop_srvs/order_srv/proto"
+	"mxshop_srvs/order_srv/saga"
+	"mxshop_srvs/order_srv/tests/fake"
+)
+
+/*
+	下单saga的测试，商品服务和库存服务使用进程内的fake，只依赖本地的mysql
+*/
+
+const testUser = 9999
+
+var inv *fake.InventoryClient
+
+func Init() {
+	initialize.InitLogger()
+	saga.Register(handler.StepInventorySell, handler.RebackCompensator)
+
+	inv = fake.NewInventoryClient(map[int32]int32{1: 10, 2: 10})
+	global.InventorySrvClient = inv
+	global.GoodsSrvClient = fake.NewGoodsClient(
+		&proto.GoodsInfoResponse{Id: 1, Name: "测试商品1", ShopPrice: 10},
+		&proto.GoodsInfoResponse{Id: 2, Name: "测试商品2", ShopPrice: 20},
+	)
+}
+
+func assertStocks(goodsId, want int32) {
+	if got := inv.Stocks(goodsId); got != want {
+		panic(fmt.Sprintf("商品%d的库存应该是%d，实际是%d", goodsId, want, got))
+	}
+}
+
+func assertSagaStatus(orderSn, want string) {
+	var s model.OrderSaga
+	global.DB.Where("order_sn = ?", orderSn).First(&s)
+	if s.Status != want {
+		panic(fmt.Sprintf("订单%s的saga状态应该是%s，实际是%s", orderSn, want, s.Status))
+	}
+}
+
+func sell(orderSn string) *saga.Saga {
+	sellInfo := &proto.SellInfo{OrderSn: orderSn, GoodsInfo: []*proto.GoodsInvInfo{{GoodsId: 1, Num: 2}}}
+	return saga.New(orderSn).AddStep(handler.StepInventorySell, sellInfo, func(ctx context.Context) error {
+		_, err := global.InventorySrvClient.Sell(ctx, sellInfo)
+		return err
+	})
+}
+
+func TestCreateOrder() {
+	global.DB.Create(&model.ShoppingCart{User: testUser, Goods: 1, Nums: 1, Checked: true})
+	global.DB.Create(&model.ShoppingCart{User: testUser, Goods: 2, Nums: 3, Checked: true})
+
+	rsp, err := (&handler.OrderServer{}).CreateOrder(context.Background(), &proto.OrderRequest{
+		UserId:  testUser,
+		Address: "北京市",
+		Name:    "bobby",
+		Mobile:  "18782222220",
+		Post:    "100000",
+	})
+	if err != nil {
+		panic(err)
+	}
+	assertStocks(1, 9)
+	assertStocks(2, 7)
+	assertSagaStatus(rsp.OrderSn, model.SagaCompleted)
+	fmt.Println("下单成功", rsp.OrderSn)
+}
+
+func TestLocalTxFailed() {
+	orderSn := handler.GenerateOrderSn(testUser)
+	before := inv.Stocks(1)
+	err := sell(orderSn).Execute(context.Background(), func(tx *gorm.DB) error {
+		return errors.New("模拟本地事务失败")
+	})
+	if err == nil {
+		panic("本地事务失败时saga应该返回错误")
+	}
+	assertStocks(1, before)
+	assertSagaStatus(orderSn, model.SagaCompensated)
+	fmt.Println("本地事务失败，库存已归还")
+}
+
+func TestCompensateRetry() {
+	orderSn := handler.GenerateOrderSn(testUser)
+	before := inv.Stocks(1)
+
+	inv.RebackErr = errors.New("模拟库存服务不可用")
+	_ = sell(orderSn).Execute(context.Background(), func(tx *gorm.DB) error {
+		return errors.New("模拟本地事务失败")
+	})
+	assertStocks(1, before-2)
+	assertSagaStatus(orderSn, model.SagaCompensateFailed)
+
+	// 库存服务恢复之后，后台任务完成补偿
+	inv.RebackErr = nil
+	saga.Recover(context.Background(), time.Minute, 10)
+	assertStocks(1, before)
+	assertSagaStatus(orderSn, model.SagaCompensated)
+	fmt.Println("补偿重试成功，库存已归还")
+}
+
+// TestSellRejected 库存不足时扣减明确失败，不调用归还，库存不变
+func TestSellRejected() {
+	orderSn := handler.GenerateOrderSn(testUser)
+	before, rebackCalls := inv.Stocks(1), inv.RebackCalls
+
+	inv.SellErr = status.Errorf(codes.ResourceExhausted, "库存不足")
+	err := sell(orderSn).Execute(context.Background(), func(tx *gorm.DB) error { return nil })
+	inv.SellErr = nil
+	if status.Code(err) != codes.ResourceExhausted {
+		panic(fmt.Sprintf("应该返回库存不足: %v", err))
+	}
+	if inv.RebackCalls != rebackCalls {
+		panic("扣减失败的步骤不应该补偿")
+	}
+	assertStocks(1, before)
+	assertSagaStatus(orderSn, model.SagaCompensated)
+	fmt.Println("库存不足，没有归还库存")
+}
+
+// TestSellTimeout 扣减超时结果不确定，需要按订单号归还
+func TestSellTimeout() {
+	orderSn := handler.GenerateOrderSn(testUser)
+	rebackCalls := inv.RebackCalls
+
+	inv.SellErr = status.Errorf(codes.DeadlineExceeded, "请求超时")
+	_ = sell(orderSn).Execute(context.Background(), func(tx *gorm.DB) error { return nil })
+	inv.SellErr = nil
+	if inv.RebackCalls != rebackCalls+1 {
+		panic("结果不确定的步骤应该补偿")
+	}
+	assertSagaStatus(orderSn, model.SagaCompensated)
+	fmt.Println("扣减超时，已经按订单号归还")
+}
+
+// TestCreateOrderSellTimeout 下单时库存已经扣减但是响应超时，步骤结果不确定，按订单号归还库存
+func TestCreateOrderSellTimeout() {
+	global.DB.Create(&model.ShoppingCart{User: testUser, Goods: 1, Nums: 2, Checked: true})
+	defer global.DB.Where(&model.ShoppingCart{User: testUser}).Delete(&model.ShoppingCart{})
+	before, rebackCalls := inv.Stocks(1), inv.RebackCalls
+
+	inv.SellErrAfterDeduct = status.Errorf(codes.DeadlineExceeded, "请求超时")
+	_, err := (&handler.OrderServer{}).CreateOrder(context.Background(), &proto.OrderRequest{
+		UserId:  testUser,
+		Address: "北京市",
+		Name:    "bobby",
+		Mobile:  "18782222220",
+		Post:    "100000",
+	})
+	inv.SellErrAfterDeduct = nil
+	if status.Code(err) != codes.ResourceExhausted {
+		panic(fmt.Sprintf("扣减库存失败时应该返回ResourceExhausted: %v", err))
+	}
+
+	var step model.OrderSagaStep
+	global.DB.Where("step = ?", handler.StepInventorySell).Order("id desc").First(&step)
+	if step.Status != model.StepCompensated {
+		panic(fmt.Sprintf("超时的扣减步骤应该被补偿，实际状态: %s", step.Status))
+	}
+	if inv.RebackCalls != rebackCalls+1 {
+		panic("超时的扣减步骤应该按订单号归还")
+	}
+	assertSagaStatus(step.OrderSn, model.SagaCompensated)
+	assertStocks(1, before)
+	fmt.Println("下单时扣减超时，库存已归还", step.OrderSn)
+}
+
+func main() {
+	Init()
+	TestCreateOrder()
+	TestLocalTxFailed()
+	TestCompensateRetry()
+	TestSellRejected()
+	TestSellTimeout()
+	TestCreateOrderSellTimeout()
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/handler"
+	"mxshop_srvs/order_srv/initialize"
+	"mxshop_srvs/order_srv/model"
+	"mxshop_srvs/order_srv/proto"
+	"mxshop_srvs/order_srv/tests/fake"
+)
+
+/*
+	有规格的商品下单的测试，商品服务和库存服务使用进程内的fake，只依赖本地的mysql
+	商品3有两个SKU，价格和库存都不一样
+*/
+
+const (
+	testUser = 9997
+	skuRed   = 31
+	skuWhite = 32
+	keyRed   = 1000000031
+	keyWhite = 1000000032
+)
+
+var (
+	orderServer = &handler.OrderServer{}
+	inv         *fake.InventoryClient
+)
+
+func Init() {
+	initialize.InitLogger()
+	inv = fake.NewInventoryClient(map[int32]int32{keyRed: 10, keyWhite: 10})
+	global.InventorySrvClient = inv
+	goods := fake.NewGoodsClient(&proto.GoodsInfoResponse{Id: 3, Name: "T恤", ShopPrice: 99, GoodsFrontImage: "t.png"})
+	goods.AddSku(&proto.SkuInfo{Id: skuRed, GoodsId: 3, ShopPrice: 79, Image: "red.png", OnSale: true, InventoryKey: keyRed,
+		Specs: []*proto.SkuSpec{{Name: "颜色", Value: "红色"}, {Name: "尺码", Value: "L"}}})
+	goods.AddSku(&proto.SkuInfo{Id: skuWhite, GoodsId: 3, ShopPrice: 89, OnSale: true, InventoryKey: keyWhite,
+		Specs: []*proto.SkuSpec{{Name: "颜色", Value: "白色"}, {Name: "尺码", Value: "M"}}})
+	global.GoodsSrvClient = goods
+}
+
+// TestCartSku 同一个商品的不同规格是不同的购物车记录
+func TestCartSku() {
+	for _, sku := range []int32{skuRed, skuWhite, skuRed} {
+		if _, err := orderServer.CreateCartItem(context.Background(), &proto.CartItemRequest{
+			UserId: testUser, GoodsId: 3, SkuId: sku, Nums: 1,
+		}); err != nil {
+			panic(err)
+		}
+	}
+	rsp, err := orderServer.CartItemList(context.Background(), &proto.UserInfo{Id: testUser})
+	if err != nil {
+		panic(err)
+	}
+	if rsp.Total != 2 {
+		panic(fmt.Sprintf("购物车应该有2条记录，实际有%d条", rsp.Total))
+	}
+	for _, sku := range []int32{skuRed, skuWhite} {
+		if _, err = orderServer.UpdateCartItem(context.Background(), &proto.CartItemRequest{
+			UserId: testUser, GoodsId: 3, SkuId: sku, Checked: true,
+		}); err != nil {
+			panic(err)
+		}
+	}
+	fmt.Println("不同规格分别加入购物车")
+}
+
+// TestOrderSku 下单使用SKU的价格，扣减SKU的库存，订单中保存规格的快照
+func TestOrderSku() {
+	rsp, err := orderServer.CreateOrder(context.Background(), &proto.OrderRequest{
+		UserId: testUser, Address: "北京市", Name: "bobby", Mobile: "18782222220", Post: "100000",
+	})
+	if err != nil {
+		panic(err)
+	}
+	if rsp.Total != 79*2+89 {
+		panic(fmt.Sprintf("订单金额应该是%d，实际是%v", 79*2+89, rsp.Total))
+	}
+	if inv.Stocks(keyRed) != 8 || inv.Stocks(keyWhite) != 9 {
+		panic(fmt.Sprintf("SKU的库存不正确：红色%d，白色%d", inv.Stocks(keyRed), inv.Stocks(keyWhite)))
+	}
+
+	var orderGoods []model.OrderGoods
+	global.DB.Where(&model.OrderGoods{Order: rsp.Id}).Find(&orderGoods)
+	for _, orderGood := range orderGoods {
+		if orderGood.Sku == skuRed && (orderGood.SkuSpecs != "颜色:红色 尺码:L" || orderGood.GoodsImage != "red.png") {
+			panic(fmt.Sprintf("规格的快照不正确: %+v", orderGood))
+		}
+		if orderGood.Sku == skuWhite && orderGood.GoodsImage != "t.png" {
+			panic("SKU没有图片时应该使用商品的图片")
+		}
+	}
+	fmt.Println("按照SKU下单", rsp.OrderSn)
+}
+
+func main() {
+	Init()
+	TestCartSku()
+	TestOrderSku()
+}
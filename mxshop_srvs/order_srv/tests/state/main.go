@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/handler"
+	"mxshop_srvs/order_srv/initialize"
+	"mxshop_srvs/order_srv/model"
+	"mxshop_srvs/order_srv/proto"
+	"mxshop_srvs/order_srv/tests/fake"
+)
+
+/*
+	订单状态机的测试，商品服务和库存服务使用进程内的fake，只依赖本地的mysql
+*/
+
+const testUser = 9997
+
+var orderServer = &handler.OrderServer{}
+
+func Init() {
+	initialize.InitLogger()
+	global.InventorySrvClient = fake.NewInventoryClient(map[int32]int32{1: 100})
+	global.GoodsSrvClient = fake.NewGoodsClient(&proto.GoodsInfoResponse{Id: 1, Name: "测试商品1", ShopPrice: 10})
+}
+
+func createOrder() *proto.OrderInfoResponse {
+	global.DB.Create(&model.ShoppingCart{User: testUser, Goods: 1, Nums: 1, Checked: true})
+	rsp, err := orderServer.CreateOrder(context.Background(), &proto.OrderRequest{
+		UserId:  testUser,
+		Address: "北京市",
+		Name:    "bobby",
+		Mobile:  "18782222220",
+		Post:    "100000",
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rsp
+}
+
+func update(orderSn string, s proto.OrderState) error {
+	_, err := orderServer.UpdateOrderStatus(context.Background(), &proto.OrderStatus{OrderSn: orderSn, State: s})
+	return err
+}
+
+func assertCode(err error, want codes.Code) {
+	if status.Code(err) != want {
+		panic(fmt.Sprintf("应该返回%s，实际返回%v", want, err))
+	}
+}
+
+// TestTransitions 允许的状态变更成功，不允许的返回FailedPrecondition
+func TestTransitions() {
+	order := createOrder()
+	assertCode(update(order.OrderSn, proto.OrderState_PAYING), codes.OK)
+	assertCode(update(order.OrderSn, proto.OrderState_TRADE_SUCCESS), codes.OK)
+	// 重复的支付通知
+	assertCode(update(order.OrderSn, proto.OrderState_TRADE_SUCCESS), codes.OK)
+	assertCode(update(order.OrderSn, proto.OrderState_TRADE_CLOSED), codes.FailedPrecondition)
+	assertCode(update(order.OrderSn, proto.OrderState_WAIT_BUYER_PAY), codes.FailedPrecondition)
+	assertCode(update(order.OrderSn, proto.OrderState_TRADE_FINISHED), codes.OK)
+	assertCode(update(order.OrderSn, proto.OrderState_ORDER_STATE_UNSPECIFIED), codes.InvalidArgument)
+	assertCode(update("not-exists", proto.OrderState_TRADE_SUCCESS), codes.NotFound)
+
+	rsp, err := orderServer.OrderDetail(context.Background(), &proto.OrderRequest{Id: order.Id, UserId: testUser})
+	if err != nil {
+		panic(err)
+	}
+	want := []proto.OrderState{
+		proto.OrderState_WAIT_BUYER_PAY,
+		proto.OrderState_PAYING,
+		proto.OrderState_TRADE_SUCCESS,
+		proto.OrderState_TRADE_FINISHED,
+	}
+	if len(rsp.History) != len(want) {
+		panic(fmt.Sprintf("应该有%d条状态记录，实际有%d条", len(want), len(rsp.History)))
+	}
+	for i, history := range rsp.History {
+		if history.To != want[i] {
+			panic(fmt.Sprintf("第%d条状态记录应该是%s，实际是%s", i+1, want[i], history.To))
+		}
+	}
+	fmt.Println("订单状态变更正确")
+}
+
+// TestClosedOrderCannotPay 关闭的订单不能再变成支付成功
+func TestClosedOrderCannotPay() {
+	order := createOrder()
+	if err := handler.CloseTimeoutOrder(context.Background(), order.OrderSn); err != nil {
+		panic(err)
+	}
+	assertCode(update(order.OrderSn, proto.OrderState_TRADE_SUCCESS), codes.FailedPrecondition)
+	fmt.Println("关闭的订单不能支付")
+}
+
+// TestLegacyStatus 旧版本的调用方只填写字符串的status，返回的订单同时有status和state
+func TestLegacyStatus() {
+	order := createOrder()
+	if _, err := orderServer.UpdateOrderStatus(context.Background(), &proto.OrderStatus{
+		OrderSn: order.OrderSn,
+		Status:  "TRADE_SUCCESS",
+	}); err != nil {
+		panic(err)
+	}
+	rsp, err := orderServer.OrderDetail(context.Background(), &proto.OrderRequest{Id: order.Id, UserId: testUser})
+	if err != nil {
+		panic(err)
+	}
+	if rsp.OrderInfo.Status != "TRADE_SUCCESS" || rsp.OrderInfo.State != proto.OrderState_TRADE_SUCCESS {
+		panic(fmt.Sprintf("订单状态不正确: %s %s", rsp.OrderInfo.Status, rsp.OrderInfo.State))
+	}
+	fmt.Println("兼容旧版本的字符串状态")
+}
+
+func main() {
+	Init()
+	TestTransitions()
+	TestClosedOrderCannotPay()
+	TestLegacyStatus()
+}
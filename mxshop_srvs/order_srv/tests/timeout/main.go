@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"mxshop_srvs/order_srv/delay"
+	"mxshop_srvs/order_srv/global"
+	"mxshop_srvs/order_srv/handler"
+	"mxshop_srvs/order_srv/initialize"
+	"mxshop_srvs/order_srv/model"
+	"mxshop_srvs/order_srv/proto"
+	"mxshop_srvs/order_srv/tests/fake"
+)
+
+/*
+	订单超时关闭的测试，商品服务和库存服务使用进程内的fake，只依赖本地的mysql
+*/
+
+const testUser = 9998
+
+var inv *fake.InventoryClient
+
+func Init() {
+	initialize.InitLogger()
+	delay.Register(handler.TopicOrderTimeout, handler.CloseTimeoutOrder)
+
+	inv = fake.NewInventoryClient(map[int32]int32{1: 10})
+	global.InventorySrvClient = inv
+	global.GoodsSrvClient = fake.NewGoodsClient(&proto.GoodsInfoResponse{Id: 1, Name: "测试商品1", ShopPrice: 10})
+}
+
+func createOrder() string {
+	global.DB.Create(&model.ShoppingCart{User: testUser, Goods: 1, Nums: 2, Checked: true})
+	rsp, err := (&handler.OrderServer{}).CreateOrder(context.Background(), &proto.OrderRequest{
+		UserId:  testUser,
+		Address: "北京市",
+		Name:    "bobby",
+		Mobile:  "18782222220",
+		Post:    "100000",
+	})
+	if err != nil {
+		panic(err)
+	}
+	return rsp.OrderSn
+}
+
+// expire 让订单的超时任务立即到期
+func expire(orderSn string) {
+	global.DB.Model(&model.DelayJob{}).Where("topic = ? and biz_key = ?", handler.TopicOrderTimeout, orderSn).
+		Update("run_at", time.Now().Add(-time.Second))
+}
+
+func TestCloseTimeoutOrder() {
+	orderSn := createOrder()
+	if inv.Stocks(1) != 8 {
+		panic("下单之后库存应该是8")
+	}
+
+	expire(orderSn)
+	delay.Poll(context.Background(), 3)
+
+	var order model.OrderInfo
+	global.DB.Where(&model.OrderInfo{OrderSn: orderSn}).First(&order)
+	if order.Status != model.OrderTradeClosed {
+		panic("超时的订单应该被关闭, 实际状态: " + order.Status)
+	}
+	if inv.Stocks(1) != 10 {
+		panic("订单关闭之后库存应该归还")
+	}
+
+	if !order.StockReturned {
+		panic("归还库存之后订单上应该有记录")
+	}
+
+	// 任务重复执行不会再调用库存服务
+	rebackCalls := inv.RebackCalls
+	if err := handler.CloseTimeoutOrder(context.Background(), orderSn); err != nil {
+		panic(err)
+	}
+	if inv.RebackCalls != rebackCalls {
+		panic("已经归还过的订单不应该再调用归还")
+	}
+	if inv.Stocks(1) != 10 {
+		panic("重复执行超时任务不应该重复归还库存")
+	}
+	fmt.Println("超时订单关闭成功")
+}
+
+func TestPaidOrderNotClosed() {
+	orderSn := createOrder()
+	global.DB.Model(&model.OrderInfo{}).Where("order_sn = ?", orderSn).Update("status", model.OrderTradeSuccess)
+
+	expire(orderSn)
+	delay.Poll(context.Background(), 3)
+
+	var order model.OrderInfo
+	global.DB.Where(&model.OrderInfo{OrderSn: orderSn}).First(&order)
+	if order.Status != model.OrderTradeSuccess || inv.Stocks(1) != 8 {
+		panic("已经支付的订单不应该被关闭")
+	}
+	fmt.Println("已支付订单不会被关闭")
+}
+
+// TestRebackRetry 归还失败时订单已经关闭但是没有记录归还，任务重试时继续归还
+func TestRebackRetry() {
+	orderSn := createOrder()
+	before := inv.Stocks(1)
+
+	inv.RebackErr = errors.New("模拟库存服务不可用")
+	if err := handler.CloseTimeoutOrder(context.Background(), orderSn); err == nil {
+		panic("归还失败时应该返回错误，任务之后重试")
+	}
+	inv.RebackErr = nil
+	var order model.OrderInfo
+	global.DB.Where(&model.OrderInfo{OrderSn: orderSn}).First(&order)
+	if order.Status != model.OrderTradeClosed || order.StockReturned {
+		panic("订单应该已经关闭，但是没有记录归还")
+	}
+
+	if err := handler.CloseTimeoutOrder(context.Background(), orderSn); err != nil {
+		panic(err)
+	}
+	if inv.Stocks(1) != before+2 {
+		panic("重试之后库存应该归还")
+	}
+	fmt.Println("归还库存重试成功")
+}
+
+func main() {
+	Init()
+	TestCloseTimeoutOrder()
+	TestPaidOrderNotClosed()
+	TestRebackRetry()
+}
@@ -0,0 +1,258 @@
+package handler
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"mxshop_srvs/user_srv/global"
+	"mxshop_srvs/user_srv/model"
+	"mxshop_srvs/user_srv/proto"
+)
+
+// maxAddresses 每个用户最多保存的收货地址数量
+const maxAddresses = 20
+
+var mobileRegexp = regexp.MustCompile(`^1[3-9]\d{9}$`)
+
+type AddressServer struct {
+	proto.UnimplementedAddressServer
+}
+
+func AddressToResponse(address model.Address) *proto.AddressResponse {
+	return &proto.AddressResponse{
+		Id:           address.ID,
+		UserId:       address.User,
+		Province:     address.Province,
+		City:         address.City,
+		District:     address.District,
+		Address:      address.Address,
+		SignerName:   address.SignerName,
+		SignerMobile: address.SignerMobile,
+		Post:         address.Post,
+		IsDefault:    address.IsDefault,
+	}
+}
+
+// checkAddress 检查地址的内容，web层已经校验过，这里按照数据库字段的长度再检查一次
+func checkAddress(req *proto.AddressRequest) error {
+	if req.UserId <= 0 {
+		return status.Errorf(codes.InvalidArgument, "用户不存在")
+	}
+	for _, field := range []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"省份", req.Province, 20},
+		{"城市", req.City, 20},
+		{"区县", req.District, 20},
+		{"详细地址", req.Address, 100},
+		{"收货人", req.SignerName, 20},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			return status.Errorf(codes.InvalidArgument, "%s不能为空", field.name)
+		}
+		if utf8.RuneCountInString(field.value) > field.max {
+			return status.Errorf(codes.InvalidArgument, "%s不能超过%d个字", field.name, field.max)
+		}
+	}
+	if !mobileRegexp.MatchString(req.SignerMobile) {
+		return status.Errorf(codes.InvalidArgument, "手机号码不合法")
+	}
+	if len(req.Post) > 20 {
+		return status.Errorf(codes.InvalidArgument, "邮编不合法")
+	}
+	return nil
+}
+
+// lockAddresses 锁住用户的所有地址，修改默认地址时不会同时出现两个默认地址
+func lockAddresses(tx *gorm.DB, userId int32) ([]model.Address, error) {
+	if userId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户不存在")
+	}
+	var addresses []model.Address
+	if result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where(&model.Address{User: userId}).
+		Order("id desc").Find(&addresses); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询收货地址失败")
+	}
+	return addresses, nil
+}
+
+// setDefault 把id设置为默认地址，用户的其他地址取消默认
+func setDefault(tx *gorm.DB, userId, id int32) error {
+	if result := tx.Model(&model.Address{}).Where("user = ? and id <> ? and is_default = ?", userId, id, true).
+		Update("is_default", false); result.Error != nil {
+		return status.Errorf(codes.Internal, "设置默认地址失败")
+	}
+	if result := tx.Model(&model.Address{}).Where("id = ?", id).Update("is_default", true); result.Error != nil {
+		return status.Errorf(codes.Internal, "设置默认地址失败")
+	}
+	return nil
+}
+
+func findAddress(tx *gorm.DB, id, userId int32) (*model.Address, error) {
+	// 零值的条件会被gorm忽略，id和用户都必须有
+	if id <= 0 || userId <= 0 {
+		return nil, status.Errorf(codes.NotFound, "收货地址不存在")
+	}
+	var address model.Address
+	if result := tx.Where(&model.Address{BaseModel: model.BaseModel{ID: id}, User: userId}).First(&address); result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "收货地址不存在")
+	}
+	return &address, nil
+}
+
+// AddressList 默认地址在最前面，其他地址按照添加时间倒序
+func (s *AddressServer) AddressList(ctx context.Context, req *proto.AddressRequest) (*proto.AddressListResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "用户不存在")
+	}
+	var addresses []model.Address
+	if result := global.DB.Where(&model.Address{User: req.UserId}).Order("is_default desc, id desc").Find(&addresses); result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "查询收货地址失败")
+	}
+	rsp := &proto.AddressListResponse{Total: int32(len(addresses))}
+	for _, address := range addresses {
+		rsp.Data = append(rsp.Data, AddressToResponse(address))
+	}
+	return rsp, nil
+}
+
+// GetAddress 只能查询自己的地址，下单时订单服务通过这个接口查询地址
+func (s *AddressServer) GetAddress(ctx context.Context, req *proto.AddressRequest) (*proto.AddressResponse, error) {
+	address, err := findAddress(global.DB, req.Id, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return AddressToResponse(*address), nil
+}
+
+func (s *AddressServer) CreateAddress(ctx context.Context, req *proto.AddressRequest) (*proto.AddressResponse, error) {
+	if err := checkAddress(req); err != nil {
+		return nil, err
+	}
+	address := model.Address{
+		User:         req.UserId,
+		Province:     req.Province,
+		City:         req.City,
+		District:     req.District,
+		Address:      req.Address,
+		SignerName:   req.SignerName,
+		SignerMobile: req.SignerMobile,
+		Post:         req.Post,
+	}
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		addresses, err := lockAddresses(tx, req.UserId)
+		if err != nil {
+			return err
+		}
+		if len(addresses) >= maxAddresses {
+			return status.Errorf(codes.ResourceExhausted, "最多保存%d个收货地址", maxAddresses)
+		}
+		// 第一个地址自动成为默认地址
+		if result := tx.Create(&address); result.Error != nil {
+			return status.Errorf(codes.Internal, "新建收货地址失败")
+		}
+		if req.IsDefault || len(addresses) == 0 {
+			address.IsDefault = true
+			return setDefault(tx, req.UserId, address.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return AddressToResponse(address), nil
+}
+
+// UpdateAddress 修改地址的内容，isDefault为true时设置为默认地址
+// 默认地址不能直接取消，需要把其他地址设置为默认地址
+func (s *AddressServer) UpdateAddress(ctx context.Context, req *proto.AddressRequest) (*empty.Empty, error) {
+	if err := checkAddress(req); err != nil {
+		return nil, err
+	}
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		address, err := findAddress(tx, req.Id, req.UserId)
+		if err != nil {
+			return err
+		}
+		address.Province = req.Province
+		address.City = req.City
+		address.District = req.District
+		address.Address = req.Address
+		address.SignerName = req.SignerName
+		address.SignerMobile = req.SignerMobile
+		address.Post = req.Post
+		if result := tx.Save(address); result.Error != nil {
+			return status.Errorf(codes.Internal, "修改收货地址失败")
+		}
+		if req.IsDefault && !address.IsDefault {
+			if _, err := lockAddresses(tx, req.UserId); err != nil {
+				return err
+			}
+			return setDefault(tx, req.UserId, address.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *AddressServer) DeleteAddress(ctx context.Context, req *proto.AddressRequest) (*empty.Empty, error) {
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		addresses, err := lockAddresses(tx, req.UserId)
+		if err != nil {
+			return err
+		}
+		var deleted *model.Address
+		var latest int32
+		for i := range addresses {
+			if addresses[i].ID == req.Id {
+				deleted = &addresses[i]
+			} else if latest == 0 {
+				// addresses按照id倒序，第一个其他的地址就是最近添加的地址
+				latest = addresses[i].ID
+			}
+		}
+		if deleted == nil {
+			return status.Errorf(codes.NotFound, "收货地址不存在")
+		}
+		if result := tx.Delete(deleted); result.Error != nil {
+			return status.Errorf(codes.Internal, "删除收货地址失败")
+		}
+		if deleted.IsDefault && latest > 0 {
+			return setDefault(tx, req.UserId, latest)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *AddressServer) SetDefaultAddress(ctx context.Context, req *proto.AddressRequest) (*empty.Empty, error) {
+	err := global.DB.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockAddresses(tx, req.UserId); err != nil {
+			return err
+		}
+		if _, err := findAddress(tx, req.Id, req.UserId); err != nil {
+			return err
+		}
+		return setDefault(tx, req.UserId, req.Id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &empty.Empty{}, nil
+}
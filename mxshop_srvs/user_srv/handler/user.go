@@ -165,3 +165,25 @@ func (s *UserServer) CheckPassWord(ctx context.Context, req *proto.PasswordCheck
 		Success: err == nil, // 当err为nil时密码正确
 	}, nil
 }
+
+// UpdatePassWord 找回密码时重新设置密码，web层已经通过短信验证码确认是手机号的主人
+func (s *UserServer) UpdatePassWord(ctx context.Context, req *proto.PasswordUpdateInfo) (*empty.Empty, error) {
+	if len(req.PassWord) < 3 || len(req.PassWord) > 20 {
+		return nil, status.Errorf(codes.InvalidArgument, "密码长度不合法")
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.PassWord), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "密码加密失败")
+	}
+	if req.Mobile == "" {
+		return nil, status.Errorf(codes.NotFound, "用户不存在")
+	}
+	result := global.DB.Model(&model.User{}).Where("mobile = ?", req.Mobile).Update("password", string(hashedPassword))
+	if result.Error != nil {
+		return nil, status.Errorf(codes.Internal, "修改密码失败")
+	}
+	if result.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "用户不存在")
+	}
+	return &empty.Empty{}, nil
+}
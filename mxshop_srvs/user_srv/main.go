@@ -38,6 +38,7 @@ func main() {
 
 	server := grpc.NewServer()
 	proto.RegisterUserServer(server, &handler.UserServer{})
+	proto.RegisterAddressServer(server, &handler.AddressServer{})
 	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", *IP, *Port))
 	if err != nil {
 		panic("failed to listen:" + err.Error())
@@ -35,5 +35,5 @@ func main() {
 		panic(err)
 	}
 	// 迁移 schema
-	_ = db.AutoMigrate(&model.User{}) //此处应该有sql语句
+	_ = db.AutoMigrate(&model.User{}, &model.Address{}) //此处应该有sql语句
 }
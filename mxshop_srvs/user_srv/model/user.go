@@ -23,3 +23,17 @@ type User struct {
 	Gender   string     `gorm:"column:gender;default:male;type:varchar(6) comment 'female表示女, male表示男'"`
 	Role     int        `gorm:"column:role;default:1;type:int comment '1表示普通用户, 2表示管理员'"`
 }
+
+// Address 用户的收货地址，每个用户最多一个默认地址
+type Address struct {
+	BaseModel
+	User         int32  `gorm:"type:int;index;not null"`
+	Province     string `gorm:"type:varchar(20);not null"`
+	City         string `gorm:"type:varchar(20);not null"`
+	District     string `gorm:"type:varchar(20);not null;comment:'区县'"`
+	Address      string `gorm:"type:varchar(100);not null;comment:'详细地址'"`
+	SignerName   string `gorm:"type:varchar(20);not null"`
+	SignerMobile string `gorm:"type:varchar(11);not null"`
+	Post         string `gorm:"type:varchar(20);not null;default:''"`
+	IsDefault    bool   `gorm:"not null;default:false"`
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var userClient proto.UserClient
+var addressClient proto.AddressClient
 var conn *grpc.ClientConn
 
 func Init() {
@@ -18,6 +19,7 @@ func Init() {
 		panic(err)
 	}
 	userClient = proto.NewUserClient(conn)
+	addressClient = proto.NewAddressClient(conn)
 }
 
 func TestGetUserList() {
@@ -53,6 +55,75 @@ func TestCreateUser() {
 		fmt.Println(rsp.Id)
 	}
 }
+
+// TestAddress 第一个地址是默认地址，删除默认地址之后最近的地址成为默认地址
+func TestAddress() {
+	var userId int32 = 1
+	newAddress := func(isDefault bool) int32 {
+		rsp, err := addressClient.CreateAddress(context.Background(), &proto.AddressRequest{
+			UserId:       userId,
+			Province:     "北京市",
+			City:         "北京市",
+			District:     "海淀区",
+			Address:      "中关村大街1号",
+			SignerName:   "bobby",
+			SignerMobile: "18782222220",
+			IsDefault:    isDefault,
+		})
+		if err != nil {
+			panic(err)
+		}
+		return rsp.Id
+	}
+	first := newAddress(false)
+	second := newAddress(true)
+	list, err := addressClient.AddressList(context.Background(), &proto.AddressRequest{UserId: userId})
+	if err != nil {
+		panic(err)
+	}
+	if list.Data[0].Id != second || !list.Data[0].IsDefault {
+		panic("新的默认地址应该在最前面")
+	}
+	if _, err := addressClient.DeleteAddress(context.Background(), &proto.AddressRequest{Id: second, UserId: userId}); err != nil {
+		panic(err)
+	}
+	address, err := addressClient.GetAddress(context.Background(), &proto.AddressRequest{Id: first, UserId: userId})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(address.Id, address.IsDefault)
+	if _, err := addressClient.GetAddress(context.Background(), &proto.AddressRequest{Id: first, UserId: userId + 1}); err == nil {
+		panic("不能查询其他用户的地址")
+	}
+}
+
+// TestUpdatePassWord 修改之后只有新的密码能通过检查
+func TestUpdatePassWord() {
+	ctx := context.Background()
+	if _, err := userClient.UpdatePassWord(ctx, &proto.PasswordUpdateInfo{
+		Mobile:   "18782222220",
+		PassWord: "newpass123",
+	}); err != nil {
+		panic(err)
+	}
+	user, err := userClient.GetUserByMobile(ctx, &proto.MobileRequest{Mobile: "18782222220"})
+	if err != nil {
+		panic(err)
+	}
+	for password, want := range map[string]bool{"newpass123": true, "admin123": false} {
+		rsp, err := userClient.CheckPassWord(ctx, &proto.PasswordCheckInfo{
+			Password:          password,
+			EncryptedPassword: user.PassWord,
+		})
+		if err != nil {
+			panic(err)
+		}
+		if rsp.Success != want {
+			panic(fmt.Sprintf("密码%s的检查结果应该是%v", password, want))
+		}
+	}
+}
+
 func main() {
 	Init()
 	TestGetUserList()